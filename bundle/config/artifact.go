@@ -8,15 +8,36 @@ import (
 	"strings"
 
 	"github.com/databricks/cli/bundle/config/paths"
+	dyn "github.com/databricks/cli/libs/config"
 	"github.com/databricks/cli/libs/process"
 	"github.com/databricks/databricks-sdk-go/service/compute"
 )
 
 type Artifacts map[string]*Artifact
 
-func (artifacts Artifacts) SetConfigFilePath(path string) {
-	for _, artifact := range artifacts {
-		artifact.ConfigFilePath = path
+// ConfigureConfigFilePath sets the path of the configuration file that defines
+// each artifact, based on its location in the dynamic configuration tree.
+func (artifacts Artifacts) ConfigureConfigFilePath(v dyn.Value, prev Artifacts) {
+	configureConfigFilePath(artifacts, prev, v, func(e *Artifact) *paths.Paths {
+		return &e.Paths
+	})
+}
+
+// retainRemotePaths copies the remote paths and library references of artifact files
+// from the previous configuration. These are not part of the dynamic configuration tree.
+func (artifacts Artifacts) retainRemotePaths(prev Artifacts) {
+	for k, artifact := range artifacts {
+		p, ok := prev[k]
+		if artifact == nil || !ok || p == nil {
+			continue
+		}
+		for i := range artifact.Files {
+			if i >= len(p.Files) || artifact.Files[i].Source != p.Files[i].Source {
+				continue
+			}
+			artifact.Files[i].RemotePath = p.Files[i].RemotePath
+			artifact.Files[i].Libraries = p.Files[i].Libraries
+		}
 	}
 }
 
@@ -28,6 +28,10 @@ type Bundle struct {
 	// Lock configures locking behavior on deployment.
 	Lock Lock `json:"lock" bundle:"readonly"`
 
+	// Deployment configures the behavior of deployments, e.g. waiting for
+	// the deployed resources to be healthy. It can be configured per target.
+	Deployment Deployment `json:"deployment,omitempty"`
+
 	// Force-override Git branch validation.
 	Force bool `json:"force,omitempty" bundle:"readonly"`
 
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// Default duration to wait for deployed resources to be healthy.
+const DefaultDeploymentWaitTimeout = 20 * time.Minute
+
+// Deployment configures the behavior of `bundle deploy`.
+type Deployment struct {
+	// Wait configures waiting for the deployed resources to be healthy.
+	Wait *DeploymentWait `json:"wait,omitempty"`
+}
+
+// DeploymentWait configures waiting for the deployed resources to be healthy
+// before a deployment completes: model serving endpoints must be ready, and
+// continuous pipelines and jobs must be running. The deployment fails if they
+// are not healthy within the timeout.
+type DeploymentWait struct {
+	// Enabled toggles waiting for the deployed resources. False by default.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Timeout is the maximum duration to wait for, e.g. "30m". Defaults to 20 minutes.
+	Timeout string `json:"timeout,omitempty"`
+}
+
+func (d Deployment) IsWaitEnabled() bool {
+	return d.Wait != nil && d.Wait.Enabled
+}
+
+// WaitTimeout returns the maximum duration to wait for the deployed resources to be healthy.
+func (d Deployment) WaitTimeout() (time.Duration, error) {
+	if d.Wait == nil || d.Wait.Timeout == "" {
+		return DefaultDeploymentWaitTimeout, nil
+	}
+	timeout, err := time.ParseDuration(d.Wait.Timeout)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid deployment wait timeout %q: expected a positive duration such as \"30m\"", d.Wait.Timeout)
+	}
+	return timeout, nil
+}
@@ -0,0 +1,22 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentWaitTimeout(t *testing.T) {
+	timeout, err := Deployment{}.WaitTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultDeploymentWaitTimeout, timeout)
+
+	timeout, err = Deployment{Wait: &DeploymentWait{Timeout: "1h"}}.WaitTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, timeout)
+
+	_, err = Deployment{Wait: &DeploymentWait{Timeout: "soon"}}.WaitTimeout()
+	assert.EqualError(t, err, `invalid deployment wait timeout "soon": expected a positive duration such as "30m"`)
+}
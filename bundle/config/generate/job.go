@@ -0,0 +1,21 @@
+package generate
+
+import (
+	"github.com/databricks/cli/libs/config"
+	"github.com/databricks/cli/libs/config/convert"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+// ConvertJobToValue converts the settings of an existing job to a configuration
+// value that can be used as the definition of a job resource in a bundle.
+func ConvertJobToValue(job *jobs.Job) (config.Value, error) {
+	var settings jobs.JobSettings
+	if job.Settings != nil {
+		settings = *job.Settings
+	}
+
+	// The deployment field is managed by the bundle.
+	settings.Deployment = nil
+
+	return convert.FromTyped(settings, config.NilValue)
+}
@@ -0,0 +1,46 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertJobToValue(t *testing.T) {
+	job := &jobs.Job{
+		JobId: 1234,
+		Settings: &jobs.JobSettings{
+			Name:              "my job",
+			MaxConcurrentRuns: 1,
+			Deployment: &jobs.JobDeployment{
+				Kind: jobs.JobDeploymentKindBundle,
+			},
+			Tasks: []jobs.Task{
+				{
+					TaskKey: "main",
+					NotebookTask: &jobs.NotebookTask{
+						NotebookPath: "/Users/foo@bar.com/notebook",
+					},
+				},
+			},
+		},
+	}
+
+	v, err := ConvertJobToValue(job)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"name":                "my job",
+		"max_concurrent_runs": int64(1),
+		"tasks": []any{
+			map[string]any{
+				"task_key": "main",
+				"notebook_task": map[string]any{
+					"notebook_path": "/Users/foo@bar.com/notebook",
+				},
+			},
+		},
+	}, v.AsAny())
+}
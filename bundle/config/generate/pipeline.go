@@ -0,0 +1,21 @@
+package generate
+
+import (
+	"github.com/databricks/cli/libs/config"
+	"github.com/databricks/cli/libs/config/convert"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+)
+
+// ConvertPipelineToValue converts the specification of an existing pipeline to a
+// configuration value that can be used as the definition of a pipeline resource in a bundle.
+func ConvertPipelineToValue(pipeline *pipelines.PipelineSpec) (config.Value, error) {
+	var spec pipelines.PipelineSpec
+	if pipeline != nil {
+		spec = *pipeline
+	}
+
+	// The ID of the pipeline is not part of its configuration.
+	spec.Id = ""
+
+	return convert.FromTyped(spec, config.NilValue)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -13,6 +12,8 @@ import (
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config/variable"
+	dyn "github.com/databricks/cli/libs/config"
+	"github.com/databricks/cli/libs/config/convert"
 	"golang.org/x/exp/maps"
 )
 
@@ -24,22 +25,33 @@ var re = regexp.MustCompile(`\$\{([a-zA-Z]+([-_]?[a-zA-Z0-9]+)*(\.[a-zA-Z]+([-_]
 type stringField struct {
 	path string
 
-	getter
-	setter
+	// Location of the string in the configuration.
+	location dyn.Location
+
+	// Current value of the string. It is updated as references are resolved.
+	value string
 }
 
-func newStringField(path string, g getter, s setter) *stringField {
+func newStringField(path string, v dyn.Value) *stringField {
 	return &stringField{
-		path: path,
+		path:     path,
+		location: v.Location(),
+		value:    v.MustString(),
+	}
+}
 
-		getter: g,
-		setter: s,
+// newScalarField returns a string field for the string representation of a scalar value.
+func newScalarField(path string, v dyn.Value) *stringField {
+	return &stringField{
+		path:     path,
+		location: v.Location(),
+		value:    fmt.Sprint(v.AsAny()),
 	}
 }
 
 func (s *stringField) dependsOn() []string {
 	var out []string
-	m := re.FindAllStringSubmatch(s.Get(), -1)
+	m := re.FindAllStringSubmatch(s.value, -1)
 	for i := range m {
 		out = append(out, m[i][1])
 	}
@@ -47,7 +59,7 @@ func (s *stringField) dependsOn() []string {
 }
 
 func (s *stringField) interpolate(fns []LookupFunction, lookup map[string]string) {
-	out := re.ReplaceAllStringFunc(s.Get(), func(s string) string {
+	s.value = re.ReplaceAllStringFunc(s.value, func(s string) string {
 		// Turn the whole match into the submatch.
 		match := re.FindStringSubmatch(s)
 		for _, fn := range fns {
@@ -64,8 +76,15 @@ func (s *stringField) interpolate(fns []LookupFunction, lookup map[string]string
 		// No substitution.
 		return s
 	})
+}
 
-	s.Set(out)
+// errorf returns an error prefixed with the location of the string field, if known.
+func (s *stringField) errorf(format string, args ...any) error {
+	msg := fmt.Sprintf(format, args...)
+	if s.location.File == "" {
+		return errors.New(msg)
+	}
+	return fmt.Errorf("%s: %s", s.location, msg)
 }
 
 type accumulator struct {
@@ -79,99 +98,83 @@ type accumulator struct {
 	memo map[string]string
 }
 
-// jsonFieldName returns the name in a field's `json` tag.
-// Returns the empty string if it isn't set.
-func jsonFieldName(sf reflect.StructField) string {
-	tag, ok := sf.Tag.Lookup("json")
-	if !ok {
-		return ""
-	}
-	parts := strings.Split(tag, ",")
-	if parts[0] == "-" {
-		return ""
-	}
-	return parts[0]
-}
-
-func (a *accumulator) walkStruct(scope []string, rv reflect.Value) {
-	num := rv.NumField()
-	for i := 0; i < num; i++ {
-		sf := rv.Type().Field(i)
-		f := rv.Field(i)
-
-		// Walk field with the same scope for anonymous (embedded) fields.
-		if sf.Anonymous {
-			a.walk(scope, f, anySetter{f})
-			continue
-		}
-
-		// Skip unnamed fields.
-		fieldName := jsonFieldName(rv.Type().Field(i))
-		if fieldName == "" {
-			continue
-		}
-
-		a.walk(append(scope, fieldName), f, anySetter{f})
-	}
+// isVariableValue returns true if the scope points to the value of a variable.
+func isVariableValue(scope []string) bool {
+	return len(scope) == 3 && scope[0] == "variables" && scope[2] == "value"
 }
 
-func (a *accumulator) walk(scope []string, rv reflect.Value, s setter) {
-	// Dereference pointer.
-	if rv.Type().Kind() == reflect.Pointer {
-		// Skip nil pointers.
-		if rv.IsNil() {
-			return
-		}
-		rv = rv.Elem()
-		s = anySetter{rv}
-	}
-
-	switch rv.Type().Kind() {
-	case reflect.String:
+func (a *accumulator) walk(scope []string, v dyn.Value) {
+	switch v.Kind() {
+	case dyn.KindString:
 		path := strings.Join(scope, Delimiter)
-		a.strings[path] = newStringField(path, anyGetter{rv}, s)
+		a.strings[path] = newStringField(path, v)
 
 		// register alias for variable value. `var.foo` would be the alias for
 		// `variables.foo.value`
-		if len(scope) == 3 && scope[0] == "variables" && scope[2] == "value" {
+		if isVariableValue(scope) {
 			aliasPath := strings.Join([]string{variable.VariableReferencePrefix, scope[1]}, Delimiter)
 			a.strings[aliasPath] = a.strings[path]
 		}
-	case reflect.Struct:
-		a.walkStruct(scope, rv)
-	case reflect.Map:
-		if rv.Type().Key().Kind() != reflect.String {
-			panic("only support string keys in map")
+	case dyn.KindBool, dyn.KindInt, dyn.KindFloat:
+		// Variables of a scalar type can be embedded in strings.
+		if isVariableValue(scope) {
+			aliasPath := strings.Join([]string{variable.VariableReferencePrefix, scope[1]}, Delimiter)
+			a.strings[aliasPath] = newScalarField(aliasPath, v)
+		}
+	case dyn.KindMap:
+		for key, value := range v.MustMap() {
+			a.walk(append(scope, key), value)
 		}
-		keys := rv.MapKeys()
-		for _, key := range keys {
-			a.walk(append(scope, key.String()), rv.MapIndex(key), mapSetter{rv, key})
+	case dyn.KindSequence:
+		// Sequences can only be nested in a map.
+		if len(scope) == 0 {
+			return
 		}
-	case reflect.Slice:
-		n := rv.Len()
 		name := scope[len(scope)-1]
 		base := scope[:len(scope)-1]
-		for i := 0; i < n; i++ {
-			element := rv.Index(i)
-			a.walk(append(base, fmt.Sprintf("%s[%d]", name, i)), element, anySetter{element})
+		for i, element := range v.MustSequence() {
+			a.walk(append(base, fmt.Sprintf("%s[%d]", name, i)), element)
 		}
 	}
 }
 
 // walk and gather all string fields in the config
-func (a *accumulator) start(v any) {
-	rv := reflect.ValueOf(v)
-	if rv.Type().Kind() != reflect.Pointer {
-		panic("expect pointer")
-	}
-	rv = rv.Elem()
-	if rv.Type().Kind() != reflect.Struct {
-		panic("expect struct")
-	}
-
+func (a *accumulator) start(v dyn.Value) {
 	a.strings = make(map[string]*stringField)
 	a.memo = make(map[string]string)
-	a.walk([]string{}, rv, nilSetter{})
+	a.walk([]string{}, v)
+}
+
+// rewrite returns a copy of the configuration tree where every string field
+// is replaced by its interpolated value. Locations are retained.
+func (a *accumulator) rewrite(scope []string, v dyn.Value) dyn.Value {
+	switch v.Kind() {
+	case dyn.KindString:
+		field, ok := a.strings[strings.Join(scope, Delimiter)]
+		if !ok || field.value == v.MustString() {
+			return v
+		}
+		return dyn.NewValue(field.value, v.Location())
+	case dyn.KindMap:
+		out := make(map[string]dyn.Value)
+		for key, value := range v.MustMap() {
+			out[key] = a.rewrite(append(scope, key), value)
+		}
+		return dyn.NewValue(out, v.Location())
+	case dyn.KindSequence:
+		if len(scope) == 0 {
+			return v
+		}
+		name := scope[len(scope)-1]
+		base := scope[:len(scope)-1]
+		out := make([]dyn.Value, len(v.MustSequence()))
+		for i, element := range v.MustSequence() {
+			out[i] = a.rewrite(append(base, fmt.Sprintf("%s[%d]", name, i)), element)
+		}
+		return dyn.NewValue(out, v.Location())
+	default:
+		return v
+	}
 }
 
 // recursively interpolate variables in a depth first manner
@@ -189,7 +192,7 @@ func (a *accumulator) Resolve(path string, seenPaths []string, fns ...LookupFunc
 
 	// return early if the string field has no variables to interpolate
 	if len(field.dependsOn()) == 0 {
-		a.memo[path] = field.Get()
+		a.memo[path] = field.value
 		return nil
 	}
 
@@ -197,7 +200,12 @@ func (a *accumulator) Resolve(path string, seenPaths []string, fns ...LookupFunc
 	for _, childFieldPath := range field.dependsOn() {
 		// error if there is a loop in variable interpolation
 		if slices.Contains(seenPaths, childFieldPath) {
-			return fmt.Errorf("cycle detected in field resolution: %s", strings.Join(append(seenPaths, childFieldPath), " -> "))
+			return field.errorf("cycle detected in field resolution: %s", strings.Join(append(seenPaths, childFieldPath), " -> "))
+		}
+
+		// error if the referenced field does not exist
+		if _, ok := a.strings[childFieldPath]; !ok {
+			return field.errorf("no value found for interpolation reference: ${%s}", childFieldPath)
 		}
 
 		// recursive resolve variables in the child fields
@@ -211,7 +219,7 @@ func (a *accumulator) Resolve(path string, seenPaths []string, fns ...LookupFunc
 	field.interpolate(fns, a.memo)
 
 	// record interpolated string in memo
-	a.memo[path] = field.Get()
+	a.memo[path] = field.value
 	return nil
 }
 
@@ -231,14 +239,102 @@ func (a *accumulator) expand(fns ...LookupFunction) error {
 	return nil
 }
 
+// variableValues returns the values of variables that are not strings, keyed by
+// the path used to reference them (e.g. `var.foo`).
+func variableValues(v dyn.Value) map[string]dyn.Value {
+	out := make(map[string]dyn.Value)
+	variables, _ := v.Get("variables").AsMap()
+	for name, def := range variables {
+		value := def.Get("value")
+		switch value.Kind() {
+		case dyn.KindNil, dyn.KindString:
+			continue
+		}
+		out[strings.Join([]string{variable.VariableReferencePrefix, name}, Delimiter)] = value
+	}
+	return out
+}
+
+// substitute replaces strings that consist of a single reference to a variable
+// that is not a string with the value of that variable. These cannot be resolved
+// by string interpolation because they are (or are part of) a map, a sequence, or
+// a field that is not a string. Variables that are maps or sequences cannot be
+// embedded in a larger string.
+func substitute(v dyn.Value, values map[string]dyn.Value, seen []string) (dyn.Value, error) {
+	switch v.Kind() {
+	case dyn.KindString:
+		s := v.MustString()
+		if path, ok := dyn.PureReference(s); ok {
+			value, ok := values[path]
+			if !ok {
+				return v, nil
+			}
+			if slices.Contains(seen, path) {
+				return dyn.NilValue, fmt.Errorf("%s: cycle detected in field resolution: %s", v.Location(), strings.Join(append(seen, path), " -> "))
+			}
+			// The value of the variable may in turn refer to other variables.
+			return substitute(value, values, append(seen, path))
+		}
+
+		for _, m := range re.FindAllStringSubmatch(s, -1) {
+			value, ok := values[m[1]]
+			if !ok {
+				continue
+			}
+			switch value.Kind() {
+			case dyn.KindMap, dyn.KindSequence:
+				return dyn.NilValue, fmt.Errorf("%s: variable %s is a %s and cannot be used as part of a string", v.Location(), m[1], value.Kind())
+			}
+		}
+		return v, nil
+	case dyn.KindMap:
+		out := make(map[string]dyn.Value)
+		for key, value := range v.MustMap() {
+			nv, err := substitute(value, values, seen)
+			if err != nil {
+				return dyn.NilValue, err
+			}
+			out[key] = nv
+		}
+		return dyn.NewValue(out, v.Location()), nil
+	case dyn.KindSequence:
+		out := make([]dyn.Value, len(v.MustSequence()))
+		for i, value := range v.MustSequence() {
+			nv, err := substitute(value, values, seen)
+			if err != nil {
+				return dyn.NilValue, err
+			}
+			out[i] = nv
+		}
+		return dyn.NewValue(out, v.Location()), nil
+	default:
+		return v, nil
+	}
+}
+
 type interpolate struct {
 	fns []LookupFunction
 }
 
-func (m *interpolate) expand(v any) error {
+// expand interpolates all string fields in the configuration tree v.
+// The type of the configuration is used to resolve references to zero-valued fields.
+func (m *interpolate) expand(typ any, v dyn.Value) (dyn.Value, error) {
+	v, err := substitute(v, variableValues(v), nil)
+	if err != nil {
+		return dyn.NilValue, err
+	}
+
+	// Include fields that are not set such that references to them resolve to their zero value.
+	lookup, _ := convert.Normalize(typ, v, convert.IncludeMissingFields)
+
 	a := accumulator{}
-	a.start(v)
-	return a.expand(m.fns...)
+	a.start(lookup)
+	err = a.expand(m.fns...)
+	if err != nil {
+		return dyn.NilValue, err
+	}
+
+	return a.rewrite([]string{}, v), nil
 }
 
 func Interpolate(fns ...LookupFunction) bundle.Mutator {
@@ -250,5 +346,7 @@ func (m *interpolate) Name() string {
 }
 
 func (m *interpolate) Apply(_ context.Context, b *bundle.Bundle) error {
-	return m.expand(&b.Config)
+	return b.Config.Mutate(func(v dyn.Value) (dyn.Value, error) {
+		return m.expand(&b.Config, v)
+	})
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/variable"
+	dyn "github.com/databricks/cli/libs/config"
+	"github.com/databricks/cli/libs/config/convert"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -30,10 +32,23 @@ type foo struct {
 	F map[string]string `json:"f"`
 }
 
+// expandTyped interpolates the typed value v through its dynamic configuration tree.
+func expandTyped(m *interpolate, v any) error {
+	nv, err := convert.FromTyped(v, dyn.NilValue)
+	if err != nil {
+		return err
+	}
+
+	nv, err = m.expand(v, nv)
+	if err != nil {
+		return err
+	}
+
+	return convert.ToTyped(v, nv)
+}
+
 func expand(v any) error {
-	a := accumulator{}
-	a.start(v)
-	return a.expand(DefaultLookup)
+	return expandTyped(&interpolate{fns: []LookupFunction{DefaultLookup}}, v)
 }
 
 func TestInterpolationVariables(t *testing.T) {
@@ -190,13 +205,13 @@ func TestInterpolationForVariables(t *testing.T) {
 	config := config.Root{
 		Variables: map[string]*variable.Variable{
 			"foo": {
-				Value: &foo,
+				Value: foo,
 			},
 			"bar": {
-				Value: &bar,
+				Value: bar,
 			},
 			"apple": {
-				Value: &apple,
+				Value: apple,
 			},
 		},
 		Bundle: config.Bundle{
@@ -206,9 +221,9 @@ func TestInterpolationForVariables(t *testing.T) {
 
 	err := expand(&config)
 	assert.NoError(t, err)
-	assert.Equal(t, "abc", *(config.Variables["foo"].Value))
-	assert.Equal(t, "abc def", *(config.Variables["bar"].Value))
-	assert.Equal(t, "abc abc def", *(config.Variables["apple"].Value))
+	assert.Equal(t, "abc", config.Variables["foo"].Value)
+	assert.Equal(t, "abc def", config.Variables["bar"].Value)
+	assert.Equal(t, "abc abc def", config.Variables["apple"].Value)
 	assert.Equal(t, "abc abc def abc", config.Bundle.Name)
 }
 
@@ -218,10 +233,10 @@ func TestInterpolationLoopForVariables(t *testing.T) {
 	config := config.Root{
 		Variables: map[string]*variable.Variable{
 			"foo": {
-				Value: &foo,
+				Value: foo,
 			},
 			"bar": {
-				Value: &bar,
+				Value: bar,
 			},
 		},
 		Bundle: config.Bundle{
@@ -238,7 +253,7 @@ func TestInterpolationInvalidVariableReference(t *testing.T) {
 	config := config.Root{
 		Variables: map[string]*variable.Variable{
 			"foo": {
-				Value: &foo,
+				Value: foo,
 			},
 		},
 		Bundle: config.Bundle{
@@ -249,3 +264,62 @@ func TestInterpolationInvalidVariableReference(t *testing.T) {
 	err := expand(&config)
 	assert.ErrorContains(t, err, "no value found for interpolation reference: ${vars.foo}")
 }
+
+func TestInterpolationForComplexVariables(t *testing.T) {
+	config := config.Root{
+		Variables: map[string]*variable.Variable{
+			"tags": {
+				Type: variable.VariableTypeMap,
+				Value: map[string]any{
+					"team": "${var.team}",
+				},
+			},
+			"team": {
+				Value: "data",
+			},
+			"count": {
+				Type:  variable.VariableTypeInt,
+				Value: int64(3),
+			},
+		},
+		Bundle: config.Bundle{
+			Name: "bundle-${var.count}",
+		},
+	}
+
+	err := expand(&config)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"team": "data"}, config.Variables["tags"].Value)
+	assert.Equal(t, "bundle-3", config.Bundle.Name)
+}
+
+func TestInterpolationComplexVariableInString(t *testing.T) {
+	config := config.Root{
+		Variables: map[string]*variable.Variable{
+			"emails": {
+				Type:  variable.VariableTypeList,
+				Value: []any{"a@example.com"},
+			},
+		},
+		Bundle: config.Bundle{
+			Name: "bundle ${var.emails}",
+		},
+	}
+
+	err := expand(&config)
+	assert.ErrorContains(t, err, "variable var.emails is a sequence and cannot be used as part of a string")
+}
+
+func TestInterpolationLoopForComplexVariables(t *testing.T) {
+	config := config.Root{
+		Variables: map[string]*variable.Variable{
+			"foo": {
+				Type:  variable.VariableTypeList,
+				Value: []any{"${var.foo}"},
+			},
+		},
+	}
+
+	err := expand(&config)
+	assert.ErrorContains(t, err, "cycle detected in field resolution: var.foo -> var.foo")
+}
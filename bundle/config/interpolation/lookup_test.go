@@ -36,7 +36,7 @@ func TestExcludePath(t *testing.T) {
 		},
 	}
 
-	err := m.expand(&tmp)
+	err := expandTyped(&m, &tmp)
 	require.NoError(t, err)
 
 	assert.Equal(t, "1", tmp.A["x"])
@@ -53,7 +53,7 @@ func TestIncludePath(t *testing.T) {
 		},
 	}
 
-	err := m.expand(&tmp)
+	err := expandTyped(&m, &tmp)
 	require.NoError(t, err)
 
 	assert.Equal(t, "1", tmp.A["x"])
@@ -71,7 +71,7 @@ func TestIncludePathMultiple(t *testing.T) {
 		},
 	}
 
-	err := m.expand(&tmp)
+	err := expandTyped(&m, &tmp)
 	require.NoError(t, err)
 
 	assert.Equal(t, "1", tmp.A["x"])
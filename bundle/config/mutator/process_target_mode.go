@@ -12,6 +12,7 @@ import (
 	"github.com/databricks/cli/libs/log"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/databricks/databricks-sdk-go/service/sql"
 )
 
 type processTargetMode struct{}
@@ -97,6 +98,42 @@ func transformDevelopmentMode(b *bundle.Bundle) error {
 		// (registered models in Unity Catalog don't yet support tags)
 	}
 
+	for i := range r.Schemas {
+		prefix = "dev_" + b.Config.Workspace.CurrentUser.ShortName + "_"
+		r.Schemas[i].Name = prefix + r.Schemas[i].Name
+		// (schemas in Unity Catalog don't yet support tags)
+	}
+
+	for i := range r.Volumes {
+		prefix = "dev_" + b.Config.Workspace.CurrentUser.ShortName + "_"
+		r.Volumes[i].Name = prefix + r.Volumes[i].Name
+		// (volumes in Unity Catalog don't yet support tags)
+	}
+
+	// SQL objects use the same display name prefix as jobs and pipelines.
+	prefix = "[dev " + shortName + "] "
+
+	for i := range r.SqlWarehouses {
+		r.SqlWarehouses[i].Name = prefix + r.SqlWarehouses[i].Name
+		if r.SqlWarehouses[i].Tags == nil {
+			r.SqlWarehouses[i].Tags = &sql.EndpointTags{}
+		}
+		r.SqlWarehouses[i].Tags.CustomTags = append(r.SqlWarehouses[i].Tags.CustomTags, sql.EndpointTagPair{Key: "dev", Value: tagValue})
+	}
+
+	for i := range r.Queries {
+		r.Queries[i].Name = prefix + r.Queries[i].Name
+	}
+
+	for i := range r.Alerts {
+		r.Alerts[i].Name = prefix + r.Alerts[i].Name
+	}
+
+	for i := range r.Dashboards {
+		r.Dashboards[i].Name = prefix + r.Dashboards[i].Name
+		r.Dashboards[i].Tags = append(r.Dashboards[i].Tags, "dev")
+	}
+
 	return nil
 }
 
@@ -17,6 +17,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/service/ml"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
 	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -79,6 +80,24 @@ func mockBundle(mode config.Mode) *bundle.Bundle {
 				RegisteredModels: map[string]*resources.RegisteredModel{
 					"registeredmodel1": {CreateRegisteredModelRequest: &catalog.CreateRegisteredModelRequest{Name: "registeredmodel1"}},
 				},
+				Schemas: map[string]*resources.Schema{
+					"schema1": {CreateSchema: &catalog.CreateSchema{Name: "schema1"}},
+				},
+				Volumes: map[string]*resources.Volume{
+					"volume1": {CreateVolumeRequestContent: &catalog.CreateVolumeRequestContent{Name: "volume1"}},
+				},
+				SqlWarehouses: map[string]*resources.SqlWarehouse{
+					"warehouse1": {CreateWarehouseRequest: &sql.CreateWarehouseRequest{Name: "warehouse1"}},
+				},
+				Queries: map[string]*resources.Query{
+					"query1": {QueryPostContent: &sql.QueryPostContent{Name: "query1"}},
+				},
+				Alerts: map[string]*resources.Alert{
+					"alert1": {CreateAlert: &sql.CreateAlert{Name: "alert1"}},
+				},
+				Dashboards: map[string]*resources.Dashboard{
+					"dashboard1": {CreateDashboardRequest: &sql.CreateDashboardRequest{Name: "dashboard1"}},
+				},
 			},
 		},
 		// Use AWS implementation for testing.
@@ -126,6 +145,22 @@ func TestProcessTargetModeDevelopment(t *testing.T) {
 
 	// Registered model 1
 	assert.Equal(t, "dev_lennart_registeredmodel1", b.Config.Resources.RegisteredModels["registeredmodel1"].Name)
+
+	// Schema 1
+	assert.Equal(t, "dev_lennart_schema1", b.Config.Resources.Schemas["schema1"].Name)
+
+	// Volume 1
+	assert.Equal(t, "dev_lennart_volume1", b.Config.Resources.Volumes["volume1"].Name)
+
+	// SQL warehouse 1
+	assert.Equal(t, "[dev lennart] warehouse1", b.Config.Resources.SqlWarehouses["warehouse1"].Name)
+	assert.Equal(t, []sql.EndpointTagPair{{Key: "dev", Value: "lennart"}}, b.Config.Resources.SqlWarehouses["warehouse1"].Tags.CustomTags)
+
+	// Query, alert and dashboard 1
+	assert.Equal(t, "[dev lennart] query1", b.Config.Resources.Queries["query1"].Name)
+	assert.Equal(t, "[dev lennart] alert1", b.Config.Resources.Alerts["alert1"].Name)
+	assert.Equal(t, "[dev lennart] dashboard1", b.Config.Resources.Dashboards["dashboard1"].Name)
+	assert.Equal(t, []string{"dev"}, b.Config.Resources.Dashboards["dashboard1"].Tags)
 }
 
 func TestProcessTargetModeDevelopmentTagNormalizationForAws(t *testing.T) {
@@ -181,6 +216,10 @@ func TestProcessTargetModeDefault(t *testing.T) {
 	assert.False(t, b.Config.Resources.Pipelines["pipeline1"].PipelineSpec.Development)
 	assert.Equal(t, "servingendpoint1", b.Config.Resources.ModelServingEndpoints["servingendpoint1"].Name)
 	assert.Equal(t, "registeredmodel1", b.Config.Resources.RegisteredModels["registeredmodel1"].Name)
+	assert.Equal(t, "schema1", b.Config.Resources.Schemas["schema1"].Name)
+	assert.Equal(t, "volume1", b.Config.Resources.Volumes["volume1"].Name)
+	assert.Equal(t, "warehouse1", b.Config.Resources.SqlWarehouses["warehouse1"].Name)
+	assert.Equal(t, "query1", b.Config.Resources.Queries["query1"].Name)
 }
 
 func TestProcessTargetModeProduction(t *testing.T) {
@@ -219,6 +258,10 @@ func TestProcessTargetModeProduction(t *testing.T) {
 	assert.False(t, b.Config.Resources.Pipelines["pipeline1"].PipelineSpec.Development)
 	assert.Equal(t, "servingendpoint1", b.Config.Resources.ModelServingEndpoints["servingendpoint1"].Name)
 	assert.Equal(t, "registeredmodel1", b.Config.Resources.RegisteredModels["registeredmodel1"].Name)
+	assert.Equal(t, "schema1", b.Config.Resources.Schemas["schema1"].Name)
+	assert.Equal(t, "volume1", b.Config.Resources.Volumes["volume1"].Name)
+	assert.Equal(t, "warehouse1", b.Config.Resources.SqlWarehouses["warehouse1"].Name)
+	assert.Equal(t, "query1", b.Config.Resources.Queries["query1"].Name)
 }
 
 func TestProcessTargetModeProductionOkForPrincipal(t *testing.T) {
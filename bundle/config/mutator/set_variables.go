@@ -2,11 +2,19 @@ package mutator
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config/variable"
+	dyn "github.com/databricks/cli/libs/config"
 	"github.com/databricks/cli/libs/env"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/redact"
+	"github.com/databricks/databricks-sdk-go"
 )
 
 const bundleVarPrefix = "BUNDLE_VAR_"
@@ -21,7 +29,39 @@ func (m *setVariables) Name() string {
 	return "SetVariables"
 }
 
-func setVariable(ctx context.Context, v *variable.Variable, name string) error {
+// Name of the file with variable values that is discovered automatically
+// in the `.databricks/bundle/<target>` directory of the bundle.
+const variableOverridesFileName = "variable-overrides.json"
+
+func setVariable(ctx context.Context, v *variable.Variable, name string, overrides map[string]dyn.Value) error {
+	if !v.Type.IsValid() {
+		return fmt.Errorf("variable %s has an unknown type: %s", name, v.Type)
+	}
+
+	if v.HasLookup() {
+		if v.HasDefault() {
+			return fmt.Errorf("variable %s cannot have both a default value and a lookup", name)
+		}
+		if v.Type.IsComplex() {
+			return fmt.Errorf("variable %s of type %s cannot have a lookup", name, v.Type)
+		}
+	}
+
+	if v.HasSecret() {
+		if v.HasDefault() {
+			return fmt.Errorf("variable %s cannot have both a default value and a secret", name)
+		}
+		if v.HasLookup() {
+			return fmt.Errorf("variable %s cannot have both a lookup and a secret", name)
+		}
+	}
+
+	// Sensitive values are redacted wherever they appear in the output, which is
+	// only meaningful for strings; a boolean or number would redact unrelated output.
+	if v.IsSensitive() && v.Type.String() != string(variable.VariableTypeString) {
+		return fmt.Errorf("variable %s of type %s cannot be sensitive; only string variables can be sensitive", name, v.Type)
+	}
+
 	// case: variable already has value initialized, so skip
 	if v.HasValue() {
 		return nil
@@ -32,32 +72,153 @@ func setVariable(ctx context.Context, v *variable.Variable, name string) error {
 	if val, ok := env.Lookup(ctx, envVarName); ok {
 		err := v.Set(val)
 		if err != nil {
-			return fmt.Errorf(`failed to assign value "%s" to variable %s from environment variable %s with error: %w`, val, name, envVarName, err)
+			return fmt.Errorf(`failed to assign value %s to variable %s from environment variable %s with error: %w`, displayValue(v, val), name, envVarName, err)
 		}
 		return nil
 	}
 
+	// case: Set the variable to its value in the automatically discovered overrides file
+	if val, ok := overrides[name]; ok {
+		err := v.SetValue(val.AsAny())
+		if err != nil {
+			return fmt.Errorf(`%s: failed to assign value to variable %s with error: %w`, val.Location(), name, err)
+		}
+		return nil
+	}
+
+	// case: read and set variable value from the local environment variable it refers to
+	if v.Env != "" {
+		if val, ok := env.Lookup(ctx, v.Env); ok {
+			err := v.Set(val)
+			if err != nil {
+				return fmt.Errorf(`failed to assign value %s to variable %s from environment variable %s with error: %w`, displayValue(v, val), name, v.Env, err)
+			}
+			return nil
+		}
+	}
+
 	// case: Set the variable to its default value
 	if v.HasDefault() {
-		err := v.Set(*v.Default)
+		err := v.SetValue(v.Default)
 		if err != nil {
-			return fmt.Errorf(`failed to assign default value from config "%s" to variable %s with error: %w`, *v.Default, name, err)
+			return fmt.Errorf(`failed to assign default value from config %s to variable %s with error: %w`, displayValue(v, v.Default), name, err)
 		}
 		return nil
 	}
 
+	// case: The variable is resolved through a lookup or secret after all other variables are set.
+	if v.HasLookup() || v.HasSecret() {
+		return nil
+	}
+
+	if v.Env != "" {
+		return fmt.Errorf(`no value assigned to required variable %s. Assignment can be done through the "--var" flag or by setting the %s or %s environment variable`, name, v.Env, bundleVarPrefix+name)
+	}
+
 	// We should have had a value to set for the variable at this point.
 	// TODO: use cmdio to request values for unassigned variables if current
 	// terminal is a tty. Tracked in https://github.com/databricks/cli/issues/379
 	return fmt.Errorf(`no value assigned to required variable %s. Assignment can be done through the "--var" flag or by setting the %s environment variable`, name, bundleVarPrefix+name)
 }
 
+func lookupVariable(ctx context.Context, w *databricks.WorkspaceClient, v *variable.Variable, name string) error {
+	id, err := v.Lookup.Resolve(ctx, w)
+	if err != nil {
+		return fmt.Errorf("failed to resolve lookup for variable %s: %w", name, err)
+	}
+
+	log.Debugf(ctx, "Resolved %s to %s for variable %s", v.Lookup, id, name)
+	return v.Set(id)
+}
+
+func secretVariable(ctx context.Context, w *databricks.WorkspaceClient, v *variable.Variable, name string) error {
+	value, err := v.Secret.Resolve(ctx, w)
+	if err != nil {
+		return fmt.Errorf("failed to resolve secret for variable %s: %w", name, err)
+	}
+
+	// Register the value before it is used anywhere else.
+	redact.Register(value)
+	log.Debugf(ctx, "Resolved secret %s for variable %s", v.Secret, name)
+	return v.Set(value)
+}
+
+// displayValue returns the quoted value for use in error messages,
+// or a placeholder if the variable is sensitive.
+func displayValue(v *variable.Variable, val any) string {
+	if v.IsSensitive() {
+		return redact.Placeholder
+	}
+	return fmt.Sprintf("%q", fmt.Sprint(val))
+}
+
+// loadVariableOverrides loads the variable values in `.databricks/bundle/<target>/variable-overrides.json`,
+// if this file exists. This allows CI systems to provide a large number of values
+// without passing them on the command line.
+func loadVariableOverrides(b *bundle.Bundle) (map[string]dyn.Value, error) {
+	if b.Config.Bundle.Target == "" {
+		return nil, nil
+	}
+
+	path := filepath.Join(b.Config.Path, ".databricks", "bundle", b.Config.Bundle.Target, variableOverridesFileName)
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	overrides, err := variable.LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, v := range overrides {
+		if _, ok := b.Config.Variables[name]; !ok {
+			return nil, fmt.Errorf("%s: variable %s has not been defined", v.Location(), name)
+		}
+	}
+	return overrides, nil
+}
+
 func (m *setVariables) Apply(ctx context.Context, b *bundle.Bundle) error {
+	overrides, err := loadVariableOverrides(b)
+	if err != nil {
+		return err
+	}
+
 	for name, variable := range b.Config.Variables {
-		err := setVariable(ctx, variable, name)
+		err := setVariable(ctx, variable, name, overrides)
 		if err != nil {
 			return err
 		}
 	}
+
+	// Resolve lookups for variables that were not assigned a value otherwise.
+	// These require a workspace client, so only construct one if needed.
+	for name, variable := range b.Config.Variables {
+		if variable.HasValue() || !variable.HasLookup() {
+			continue
+		}
+		err := lookupVariable(ctx, b.WorkspaceClient(), variable, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Resolve secrets for variables that were not assigned a value otherwise.
+	for name, variable := range b.Config.Variables {
+		if variable.HasValue() || !variable.HasSecret() {
+			continue
+		}
+		err := secretVariable(ctx, b.WorkspaceClient(), variable, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Make sure that the values of sensitive variables are never displayed.
+	for _, variable := range b.Config.Variables {
+		if s, ok := variable.Value.(string); ok && variable.IsSensitive() {
+			redact.Register(s)
+		}
+	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/libs/redact"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -15,27 +16,27 @@ func TestSetVariableFromProcessEnvVar(t *testing.T) {
 	defaultVal := "default"
 	variable := variable.Variable{
 		Description: "a test variable",
-		Default:     &defaultVal,
+		Default:     defaultVal,
 	}
 
 	// set value for variable as an environment variable
 	t.Setenv("BUNDLE_VAR_foo", "process-env")
 
-	err := setVariable(context.Background(), &variable, "foo")
+	err := setVariable(context.Background(), &variable, "foo", nil)
 	require.NoError(t, err)
-	assert.Equal(t, *variable.Value, "process-env")
+	assert.Equal(t, variable.Value, "process-env")
 }
 
 func TestSetVariableUsingDefaultValue(t *testing.T) {
 	defaultVal := "default"
 	variable := variable.Variable{
 		Description: "a test variable",
-		Default:     &defaultVal,
+		Default:     defaultVal,
 	}
 
-	err := setVariable(context.Background(), &variable, "foo")
+	err := setVariable(context.Background(), &variable, "foo", nil)
 	require.NoError(t, err)
-	assert.Equal(t, *variable.Value, "default")
+	assert.Equal(t, variable.Value, "default")
 }
 
 func TestSetVariableWhenAlreadyAValueIsAssigned(t *testing.T) {
@@ -43,15 +44,15 @@ func TestSetVariableWhenAlreadyAValueIsAssigned(t *testing.T) {
 	val := "assigned-value"
 	variable := variable.Variable{
 		Description: "a test variable",
-		Default:     &defaultVal,
-		Value:       &val,
+		Default:     defaultVal,
+		Value:       val,
 	}
 
 	// since a value is already assigned to the variable, it would not be overridden
 	// by the default value
-	err := setVariable(context.Background(), &variable, "foo")
+	err := setVariable(context.Background(), &variable, "foo", nil)
 	require.NoError(t, err)
-	assert.Equal(t, *variable.Value, "assigned-value")
+	assert.Equal(t, variable.Value, "assigned-value")
 }
 
 func TestSetVariableEnvVarValueDoesNotOverridePresetValue(t *testing.T) {
@@ -59,8 +60,8 @@ func TestSetVariableEnvVarValueDoesNotOverridePresetValue(t *testing.T) {
 	val := "assigned-value"
 	variable := variable.Variable{
 		Description: "a test variable",
-		Default:     &defaultVal,
-		Value:       &val,
+		Default:     defaultVal,
+		Value:       val,
 	}
 
 	// set value for variable as an environment variable
@@ -68,9 +69,9 @@ func TestSetVariableEnvVarValueDoesNotOverridePresetValue(t *testing.T) {
 
 	// since a value is already assigned to the variable, it would not be overridden
 	// by the value from environment
-	err := setVariable(context.Background(), &variable, "foo")
+	err := setVariable(context.Background(), &variable, "foo", nil)
 	require.NoError(t, err)
-	assert.Equal(t, *variable.Value, "assigned-value")
+	assert.Equal(t, variable.Value, "assigned-value")
 }
 
 func TestSetVariablesErrorsIfAValueCouldNotBeResolved(t *testing.T) {
@@ -79,7 +80,7 @@ func TestSetVariablesErrorsIfAValueCouldNotBeResolved(t *testing.T) {
 	}
 
 	// fails because we could not resolve a value for the variable
-	err := setVariable(context.Background(), &variable, "foo")
+	err := setVariable(context.Background(), &variable, "foo", nil)
 	assert.ErrorContains(t, err, "no value assigned to required variable foo. Assignment can be done through the \"--var\" flag or by setting the BUNDLE_VAR_foo environment variable")
 }
 
@@ -92,15 +93,15 @@ func TestSetVariablesMutator(t *testing.T) {
 			Variables: map[string]*variable.Variable{
 				"a": {
 					Description: "resolved to default value",
-					Default:     &defaultValForA,
+					Default:     defaultValForA,
 				},
 				"b": {
 					Description: "resolved from environment vairables",
-					Default:     &defaultValForB,
+					Default:     defaultValForB,
 				},
 				"c": {
 					Description: "has already been assigned a value",
-					Value:       &valForC,
+					Value:       valForC,
 				},
 			},
 		},
@@ -110,7 +111,166 @@ func TestSetVariablesMutator(t *testing.T) {
 
 	err := bundle.Apply(context.Background(), b, SetVariables())
 	require.NoError(t, err)
-	assert.Equal(t, "default-a", *b.Config.Variables["a"].Value)
-	assert.Equal(t, "env-var-b", *b.Config.Variables["b"].Value)
-	assert.Equal(t, "assigned-val-c", *b.Config.Variables["c"].Value)
+	assert.Equal(t, "default-a", b.Config.Variables["a"].Value)
+	assert.Equal(t, "env-var-b", b.Config.Variables["b"].Value)
+	assert.Equal(t, "assigned-val-c", b.Config.Variables["c"].Value)
+}
+
+func TestSetVariableWithLookupIsNotRequired(t *testing.T) {
+	variable := variable.Variable{
+		Description: "a test variable",
+		Lookup: &variable.Lookup{
+			ClusterPolicy: "Team Default",
+		},
+	}
+
+	// The lookup is resolved after all other variables are set.
+	err := setVariable(context.Background(), &variable, "foo", nil)
+	require.NoError(t, err)
+	assert.False(t, variable.HasValue())
+}
+
+func TestSetVariableWithLookupUsesEnvVar(t *testing.T) {
+	variable := variable.Variable{
+		Description: "a test variable",
+		Lookup: &variable.Lookup{
+			ClusterPolicy: "Team Default",
+		},
+	}
+
+	t.Setenv("BUNDLE_VAR_foo", "1234")
+
+	err := setVariable(context.Background(), &variable, "foo", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "1234", variable.Value)
+}
+
+func TestSetVariableWithLookupAndDefault(t *testing.T) {
+	variable := variable.Variable{
+		Description: "a test variable",
+		Default:     "1234",
+		Lookup: &variable.Lookup{
+			ClusterPolicy: "Team Default",
+		},
+	}
+
+	err := setVariable(context.Background(), &variable, "foo", nil)
+	assert.ErrorContains(t, err, "variable foo cannot have both a default value and a lookup")
+}
+
+func TestSetVariableFromNamedEnvVar(t *testing.T) {
+	variable := variable.Variable{
+		Default: "default",
+		Env:     "MY_TOKEN",
+	}
+
+	t.Setenv("MY_TOKEN", "from-env")
+
+	err := setVariable(context.Background(), &variable, "foo", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", variable.Value)
+}
+
+func TestSetVariableFromNamedEnvVarFallsBackToDefault(t *testing.T) {
+	variable := variable.Variable{
+		Default: "default",
+		Env:     "MY_UNSET_TOKEN",
+	}
+
+	err := setVariable(context.Background(), &variable, "foo", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "default", variable.Value)
+}
+
+func TestSetVariableFromNamedEnvVarRequired(t *testing.T) {
+	variable := variable.Variable{
+		Env: "MY_UNSET_TOKEN",
+	}
+
+	err := setVariable(context.Background(), &variable, "foo", nil)
+	assert.ErrorContains(t, err, "by setting the MY_UNSET_TOKEN or BUNDLE_VAR_foo environment variable")
+}
+
+func TestSetVariableWithSecretIsNotRequired(t *testing.T) {
+	variable := variable.Variable{
+		Secret: &variable.Secret{Scope: "ci", Key: "token"},
+	}
+
+	// The secret is resolved after all other variables are set.
+	err := setVariable(context.Background(), &variable, "foo", nil)
+	require.NoError(t, err)
+	assert.False(t, variable.HasValue())
+}
+
+func TestSetVariableWithSecretAndDefault(t *testing.T) {
+	variable := variable.Variable{
+		Default: "1234",
+		Secret:  &variable.Secret{Scope: "ci", Key: "token"},
+	}
+
+	err := setVariable(context.Background(), &variable, "foo", nil)
+	assert.ErrorContains(t, err, "variable foo cannot have both a default value and a secret")
+}
+
+func TestSetVariableSensitiveComplexType(t *testing.T) {
+	variable := variable.Variable{
+		Type:      variable.VariableTypeMap,
+		Sensitive: true,
+	}
+
+	err := setVariable(context.Background(), &variable, "foo", nil)
+	assert.ErrorContains(t, err, "variable foo of type map cannot be sensitive")
+}
+
+func TestSetVariableSensitiveScalarType(t *testing.T) {
+	variable := variable.Variable{
+		Type:      variable.VariableTypeInt,
+		Sensitive: true,
+	}
+
+	t.Setenv("BUNDLE_VAR_foo", "s3cr3t")
+
+	err := setVariable(context.Background(), &variable, "foo", nil)
+	assert.ErrorContains(t, err, "variable foo of type int cannot be sensitive; only string variables can be sensitive")
+	assert.NotContains(t, err.Error(), "s3cr3t")
+}
+
+func TestSetVariablesSensitiveBoolDoesNotRedactOutput(t *testing.T) {
+	t.Cleanup(redact.Reset)
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Variables: map[string]*variable.Variable{
+				"enabled": {
+					Type:      variable.VariableTypeBool,
+					Default:   true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, SetVariables())
+	assert.ErrorContains(t, err, "variable enabled of type bool cannot be sensitive")
+	assert.Equal(t, "enabled: true", redact.String("enabled: true"))
+}
+
+func TestSetVariablesRegistersSensitiveValues(t *testing.T) {
+	t.Cleanup(redact.Reset)
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Variables: map[string]*variable.Variable{
+				"token": {
+					Default:   "dont-print-me",
+					Sensitive: true,
+				},
+				"name": {
+					Default: "print-me",
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, SetVariables())
+	require.NoError(t, err)
+	assert.Equal(t, "token=********, name=print-me", redact.String("token=dont-print-me, name=print-me"))
 }
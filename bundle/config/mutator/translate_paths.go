@@ -193,6 +193,7 @@ func (m *translatePaths) Apply(_ context.Context, b *bundle.Bundle) error {
 		applyJobTransformers,
 		applyPipelineTransformers,
 		applyArtifactTransformers,
+		applyQueryTransformers,
 	} {
 		err := fn(m, b)
 		if err != nil {
@@ -0,0 +1,83 @@
+package mutator
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+)
+
+func translateQueryPath(literal, localFullPath, localRelPath, remotePath string) (string, error) {
+	info, err := os.Stat(localFullPath)
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("query file %s not found", literal)
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to access %s: %w", localFullPath, err)
+	}
+	if info.IsDir() || filepath.Ext(localFullPath) != ".sql" {
+		return "", fmt.Errorf("query file %s is not a .sql file", literal)
+	}
+	return remotePath, nil
+}
+
+func transformQueryFile(resource any, dir string) *transformer {
+	query, ok := resource.(*resources.Query)
+	if !ok || query.File == "" {
+		return nil
+	}
+
+	return &transformer{
+		dir,
+		&query.File,
+		"file",
+		translateQueryPath,
+	}
+}
+
+func applyQueryTransformers(m *translatePaths, b *bundle.Bundle) error {
+	queryTransformers := []transformFunc{
+		transformQueryFile,
+	}
+
+	for key, query := range b.Config.Resources.Queries {
+		if query.File == "" {
+			continue
+		}
+		if query.QueryPostContent != nil && query.Query != "" {
+			return fmt.Errorf("query %s cannot specify both query and file", key)
+		}
+		if path.IsAbs(filepath.ToSlash(query.File)) {
+			return fmt.Errorf("file of query %s must be a path relative to the configuration file", key)
+		}
+
+		dir, err := query.ConfigFileDirectory()
+		if err != nil {
+			return fmt.Errorf("unable to determine directory for query %s: %w", key, err)
+		}
+
+		// The file is translated to the path of its synchronized copy in the workspace,
+		// like other paths. Its existence and type are checked by [translateQueryPath].
+		localPath := filepath.Join(dir, filepath.FromSlash(query.File))
+		err = m.applyTransformers(queryTransformers, b, query, dir)
+		if err != nil {
+			return err
+		}
+
+		// The text of the query is included in the query definition itself.
+		raw, err := os.ReadFile(localPath)
+		if err != nil {
+			return fmt.Errorf("unable to read query file for query %s: %w", key, err)
+		}
+		if query.QueryPostContent == nil {
+			query.QueryPostContent = &sql.QueryPostContent{}
+		}
+		query.Query = string(raw)
+	}
+
+	return nil
+}
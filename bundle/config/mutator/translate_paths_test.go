@@ -14,6 +14,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -670,3 +671,86 @@ func TestPipelineFileLibraryWithNotebookSourceError(t *testing.T) {
 	err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
 	assert.ErrorContains(t, err, `expected a file for "libraries.file.path" but got a notebook`)
 }
+
+func TestTranslatePathsQueryFile(t *testing.T) {
+	dir := t.TempDir()
+	err := os.MkdirAll(filepath.Join(dir, "queries"), 0700)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(dir, "queries", "revenue.sql"), []byte("SELECT sum(amount) FROM sales"), 0600)
+	require.NoError(t, err)
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Workspace: config.Workspace{
+				FilePath: "/bundle",
+			},
+			Resources: config.Resources{
+				Queries: map[string]*resources.Query{
+					"query": {
+						Paths: paths.Paths{
+							ConfigFilePath: filepath.Join(dir, "resources", "resource.yml"),
+						},
+						File: "../queries/revenue.sql",
+						QueryPostContent: &sql.QueryPostContent{
+							Name: "revenue",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err = bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+	require.NoError(t, err)
+
+	assert.Equal(t, "/bundle/queries/revenue.sql", b.Config.Resources.Queries["query"].File)
+	assert.Equal(t, "SELECT sum(amount) FROM sales", b.Config.Resources.Queries["query"].Query)
+}
+
+func TestTranslatePathsQueryFileErrors(t *testing.T) {
+	dir := t.TempDir()
+	touchEmptyFile(t, filepath.Join(dir, "query.txt"))
+
+	for _, tc := range []struct {
+		name  string
+		query *resources.Query
+		err   string
+	}{
+		{
+			name:  "not found",
+			query: &resources.Query{File: "./doesnt_exist.sql"},
+			err:   "query file ./doesnt_exist.sql not found",
+		},
+		{
+			name:  "wrong extension",
+			query: &resources.Query{File: "./query.txt"},
+			err:   "query file ./query.txt is not a .sql file",
+		},
+		{
+			name:  "both query and file",
+			query: &resources.Query{File: "./query.sql", QueryPostContent: &sql.QueryPostContent{Query: "SELECT 1"}},
+			err:   "query query cannot specify both query and file",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.query.ConfigFilePath = filepath.Join(dir, "resource.yml")
+			b := &bundle.Bundle{
+				Config: config.Root{
+					Path: dir,
+					Workspace: config.Workspace{
+						FilePath: "/bundle",
+					},
+					Resources: config.Resources{
+						Queries: map[string]*resources.Query{
+							"query": tc.query,
+						},
+					},
+				},
+			}
+
+			err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package config
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/databricks/cli/bundle/config/paths"
 	"github.com/databricks/cli/bundle/config/resources"
+	dyn "github.com/databricks/cli/libs/config"
+	"github.com/databricks/databricks-sdk-go"
 )
 
 // Resources defines Databricks resources associated with the bundle.
@@ -15,6 +19,14 @@ type Resources struct {
 	Experiments           map[string]*resources.MlflowExperiment     `json:"experiments,omitempty"`
 	ModelServingEndpoints map[string]*resources.ModelServingEndpoint `json:"model_serving_endpoints,omitempty"`
 	RegisteredModels      map[string]*resources.RegisteredModel      `json:"registered_models,omitempty"`
+
+	Schemas map[string]*resources.Schema `json:"schemas,omitempty"`
+	Volumes map[string]*resources.Volume `json:"volumes,omitempty"`
+
+	SqlWarehouses map[string]*resources.SqlWarehouse `json:"sql_warehouses,omitempty"`
+	Queries       map[string]*resources.Query        `json:"queries,omitempty"`
+	Alerts        map[string]*resources.Alert        `json:"alerts,omitempty"`
+	Dashboards    map[string]*resources.Dashboard    `json:"dashboards,omitempty"`
 }
 
 type UniqueResourceIdTracker struct {
@@ -121,31 +133,146 @@ func (r *Resources) VerifyUniqueResourceIdentifiers() (*UniqueResourceIdTracker,
 		tracker.Type[k] = "registered_model"
 		tracker.ConfigPath[k] = r.RegisteredModels[k].ConfigFilePath
 	}
-	return tracker, nil
-}
-
-// SetConfigFilePath sets the specified path for all resources contained in this instance.
-// This property is used to correctly resolve paths relative to the path
-// of the configuration file they were defined in.
-func (r *Resources) SetConfigFilePath(path string) {
-	for _, e := range r.Jobs {
-		e.ConfigFilePath = path
+	for k := range r.Schemas {
+		if _, ok := tracker.Type[k]; ok {
+			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
+				k,
+				tracker.Type[k],
+				tracker.ConfigPath[k],
+				"schema",
+				r.Schemas[k].ConfigFilePath,
+			)
+		}
+		tracker.Type[k] = "schema"
+		tracker.ConfigPath[k] = r.Schemas[k].ConfigFilePath
 	}
-	for _, e := range r.Pipelines {
-		e.ConfigFilePath = path
+	for k := range r.Volumes {
+		if _, ok := tracker.Type[k]; ok {
+			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
+				k,
+				tracker.Type[k],
+				tracker.ConfigPath[k],
+				"volume",
+				r.Volumes[k].ConfigFilePath,
+			)
+		}
+		tracker.Type[k] = "volume"
+		tracker.ConfigPath[k] = r.Volumes[k].ConfigFilePath
 	}
-	for _, e := range r.Models {
-		e.ConfigFilePath = path
+	for k := range r.SqlWarehouses {
+		if _, ok := tracker.Type[k]; ok {
+			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
+				k,
+				tracker.Type[k],
+				tracker.ConfigPath[k],
+				"sql_warehouse",
+				r.SqlWarehouses[k].ConfigFilePath,
+			)
+		}
+		tracker.Type[k] = "sql_warehouse"
+		tracker.ConfigPath[k] = r.SqlWarehouses[k].ConfigFilePath
 	}
-	for _, e := range r.Experiments {
-		e.ConfigFilePath = path
+	for k := range r.Queries {
+		if _, ok := tracker.Type[k]; ok {
+			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
+				k,
+				tracker.Type[k],
+				tracker.ConfigPath[k],
+				"query",
+				r.Queries[k].ConfigFilePath,
+			)
+		}
+		tracker.Type[k] = "query"
+		tracker.ConfigPath[k] = r.Queries[k].ConfigFilePath
 	}
-	for _, e := range r.ModelServingEndpoints {
-		e.ConfigFilePath = path
+	for k := range r.Alerts {
+		if _, ok := tracker.Type[k]; ok {
+			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
+				k,
+				tracker.Type[k],
+				tracker.ConfigPath[k],
+				"alert",
+				r.Alerts[k].ConfigFilePath,
+			)
+		}
+		tracker.Type[k] = "alert"
+		tracker.ConfigPath[k] = r.Alerts[k].ConfigFilePath
 	}
-	for _, e := range r.RegisteredModels {
-		e.ConfigFilePath = path
+	for k := range r.Dashboards {
+		if _, ok := tracker.Type[k]; ok {
+			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
+				k,
+				tracker.Type[k],
+				tracker.ConfigPath[k],
+				"dashboard",
+				r.Dashboards[k].ConfigFilePath,
+			)
+		}
+		tracker.Type[k] = "dashboard"
+		tracker.ConfigPath[k] = r.Dashboards[k].ConfigFilePath
 	}
+	return tracker, nil
+}
+
+// configureConfigFilePath sets the configuration file path of every entry in the map
+// to the file it was defined in, according to its location in the dynamic configuration tree.
+// Entries without a location (e.g. those defined programmatically) retain their previous path.
+func configureConfigFilePath[T any](m map[string]*T, prev map[string]*T, v dyn.Value, fn func(*T) *paths.Paths) {
+	for k, e := range m {
+		if e == nil {
+			continue
+		}
+		if file := v.Get(k).Location().File; file != "" {
+			fn(e).ConfigFilePath = file
+			continue
+		}
+		if p, ok := prev[k]; ok && p != nil {
+			fn(e).ConfigFilePath = fn(p).ConfigFilePath
+		}
+	}
+}
+
+// ConfigureConfigFilePath sets the path of the configuration file that defines
+// each resource, based on its location in the dynamic configuration tree.
+// This property is used to correctly resolve paths relative to the path
+// of the configuration file they were defined in.
+func (r *Resources) ConfigureConfigFilePath(v dyn.Value, prev *Resources) {
+	configureConfigFilePath(r.Jobs, prev.Jobs, v.Get("jobs"), func(e *resources.Job) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.Pipelines, prev.Pipelines, v.Get("pipelines"), func(e *resources.Pipeline) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.Models, prev.Models, v.Get("models"), func(e *resources.MlflowModel) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.Experiments, prev.Experiments, v.Get("experiments"), func(e *resources.MlflowExperiment) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.ModelServingEndpoints, prev.ModelServingEndpoints, v.Get("model_serving_endpoints"), func(e *resources.ModelServingEndpoint) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.RegisteredModels, prev.RegisteredModels, v.Get("registered_models"), func(e *resources.RegisteredModel) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.Schemas, prev.Schemas, v.Get("schemas"), func(e *resources.Schema) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.Volumes, prev.Volumes, v.Get("volumes"), func(e *resources.Volume) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.SqlWarehouses, prev.SqlWarehouses, v.Get("sql_warehouses"), func(e *resources.SqlWarehouse) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.Queries, prev.Queries, v.Get("queries"), func(e *resources.Query) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.Alerts, prev.Alerts, v.Get("alerts"), func(e *resources.Alert) *paths.Paths {
+		return &e.Paths
+	})
+	configureConfigFilePath(r.Dashboards, prev.Dashboards, v.Get("dashboards"), func(e *resources.Dashboard) *paths.Paths {
+		return &e.Paths
+	})
 }
 
 // Merge iterates over all resources and merges chunks of the
@@ -168,3 +295,35 @@ func (r *Resources) Merge() error {
 	}
 	return nil
 }
+
+// ConfigResource is a resource that can be bound to an existing object in the workspace.
+type ConfigResource interface {
+	// Exists returns true if an object with the specified ID exists in the workspace.
+	Exists(ctx context.Context, w *databricks.WorkspaceClient, id string) (bool, error)
+
+	// TerraformResourceName returns the name of the Terraform resource type.
+	TerraformResourceName() string
+}
+
+// FindResourceByConfigKey returns the resource with the specified key.
+// Only resources that implement [ConfigResource] can be found.
+func (r *Resources) FindResourceByConfigKey(key string) (ConfigResource, error) {
+	var found []ConfigResource
+	if v, ok := r.Jobs[key]; ok {
+		found = append(found, v)
+	}
+	if v, ok := r.Pipelines[key]; ok {
+		found = append(found, v)
+	}
+	if v, ok := r.Models[key]; ok {
+		found = append(found, v)
+	}
+
+	if len(found) == 0 {
+		return nil, fmt.Errorf("no job, pipeline or model found with key %s", key)
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("ambiguous: multiple resources found with key %s", key)
+	}
+	return found[0], nil
+}
@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"github.com/databricks/cli/bundle/config/paths"
+	"github.com/databricks/databricks-sdk-go/marshal"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+)
+
+type Alert struct {
+	ID          string       `json:"id,omitempty" bundle:"readonly"`
+	Permissions []Permission `json:"permissions,omitempty"`
+
+	paths.Paths
+
+	*sql.CreateAlert
+}
+
+func (s *Alert) UnmarshalJSON(b []byte) error {
+	return marshal.Unmarshal(b, s)
+}
+
+func (s Alert) MarshalJSON() ([]byte, error) {
+	return marshal.Marshal(s)
+}
@@ -1,8 +1,12 @@
 package resources
 
 import (
+	"context"
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/qa"
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/stretchr/testify/assert"
@@ -114,3 +118,36 @@ func TestJobMergeTasks(t *testing.T) {
 	task1 := j.Tasks[1].NewCluster
 	assert.Equal(t, "10.4.x-scala2.12", task1.SparkVersion)
 }
+
+func TestJobExists(t *testing.T) {
+	cfg, server := qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/get?job_id=123",
+			Response: jobs.Job{JobId: 123},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/get?job_id=456",
+			Status:   404,
+			Response: apierr.APIErrorBody{
+				ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+				Message:   "Job 456 does not exist.",
+			},
+		},
+	}.Config(t)
+	defer server.Close()
+	w := databricks.Must(databricks.NewWorkspaceClient((*databricks.Config)(cfg)))
+
+	j := &Job{}
+	exists, err := j.Exists(context.Background(), w, "123")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = j.Exists(context.Background(), w, "456")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = j.Exists(context.Background(), w, "abc")
+	assert.Error(t, err)
+}
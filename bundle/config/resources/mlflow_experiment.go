@@ -7,6 +7,7 @@ import (
 )
 
 type MlflowExperiment struct {
+	ID          string       `json:"id,omitempty" bundle:"readonly"`
 	Permissions []Permission `json:"permissions,omitempty"`
 
 	paths.Paths
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"github.com/databricks/cli/bundle/config/paths"
+	"github.com/databricks/databricks-sdk-go/marshal"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+)
+
+type Query struct {
+	ID          string       `json:"id,omitempty" bundle:"readonly"`
+	Permissions []Permission `json:"permissions,omitempty"`
+
+	// Path to a .sql file that contains the text of the query, relative to
+	// the configuration file the query is defined in. The text is read from this
+	// file during deployment. It is mutually exclusive with the `query` field.
+	File string `json:"file,omitempty"`
+
+	paths.Paths
+
+	*sql.QueryPostContent
+}
+
+func (s *Query) UnmarshalJSON(b []byte) error {
+	return marshal.Unmarshal(b, s)
+}
+
+func (s Query) MarshalJSON() ([]byte, error) {
+	return marshal.Marshal(s)
+}
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"github.com/databricks/cli/bundle/config/paths"
+	"github.com/databricks/databricks-sdk-go/marshal"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+)
+
+type SqlWarehouse struct {
+	ID          string       `json:"id,omitempty" bundle:"readonly"`
+	Permissions []Permission `json:"permissions,omitempty"`
+
+	// ID of the data source for this warehouse. Queries refer to their
+	// warehouse by this ID, e.g. `${resources.sql_warehouses.x.data_source_id}`.
+	// This value is returned by terraform.
+	DataSourceID string `json:"data_source_id,omitempty" bundle:"readonly"`
+
+	paths.Paths
+
+	*sql.CreateWarehouseRequest
+}
+
+func (s *SqlWarehouse) UnmarshalJSON(b []byte) error {
+	return marshal.Unmarshal(b, s)
+}
+
+func (s SqlWarehouse) MarshalJSON() ([]byte, error) {
+	return marshal.Marshal(s)
+}
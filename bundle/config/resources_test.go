@@ -125,3 +125,32 @@ func TestVerifySafeMergeForRegisteredModels(t *testing.T) {
 	err := r.VerifySafeMerge(&other)
 	assert.ErrorContains(t, err, "multiple resources named bar (registered_model at bar.yml, registered_model at bar2.yml)")
 }
+
+func TestFindResourceByConfigKey(t *testing.T) {
+	r := Resources{
+		Jobs: map[string]*resources.Job{
+			"foo": {},
+		},
+		Pipelines: map[string]*resources.Pipeline{
+			"bar": {},
+		},
+		Models: map[string]*resources.MlflowModel{
+			"baz": {},
+		},
+	}
+
+	res, err := r.FindResourceByConfigKey("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "databricks_job", res.TerraformResourceName())
+
+	res, err = r.FindResourceByConfigKey("bar")
+	assert.NoError(t, err)
+	assert.Equal(t, "databricks_pipeline", res.TerraformResourceName())
+
+	res, err = r.FindResourceByConfigKey("baz")
+	assert.NoError(t, err)
+	assert.Equal(t, "databricks_mlflow_model", res.TerraformResourceName())
+
+	_, err = r.FindResourceByConfigKey("qux")
+	assert.EqualError(t, err, "no job, pipeline or model found with key qux")
+}
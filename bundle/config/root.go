@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -8,12 +9,22 @@ import (
 
 	"github.com/databricks/cli/bundle/config/resources"
 	"github.com/databricks/cli/bundle/config/variable"
+	dyn "github.com/databricks/cli/libs/config"
+	"github.com/databricks/cli/libs/config/convert"
+	"github.com/databricks/cli/libs/config/merge"
+	"github.com/databricks/cli/libs/config/yamlloader"
+	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
-	"github.com/ghodss/yaml"
-	"github.com/imdario/mergo"
 )
 
 type Root struct {
+	// Dynamic configuration tree this configuration was loaded from.
+	// It retains the source location of every value.
+	value dyn.Value
+
+	// Diagnostics (e.g. unknown fields) collected while loading the configuration.
+	diags diag.Diagnostics
+
 	// Path contains the directory path to the root of the bundle.
 	// It is set when loading `databricks.yml`.
 	Path string `json:"-" bundle:"readonly"`
@@ -70,49 +81,120 @@ func Load(path string) (*Root, error) {
 		return nil, err
 	}
 
-	var r Root
-	err = yaml.Unmarshal(raw, &r)
+	r := Root{
+		Path: filepath.Dir(path),
+	}
+
+	// Load configuration tree from YAML.
+	v, err := yamlloader.LoadYAML(path, bytes.NewBuffer(raw))
 	if err != nil {
 		return nil, fmt.Errorf("failed to load %s: %w", path, err)
 	}
 
-	if r.Environments != nil && r.Targets != nil {
-		return nil, fmt.Errorf("both 'environments' and 'targets' are specified, only 'targets' should be used: %s", path)
+	// Rewrite the deprecated "environments" section to "targets".
+	v, err = rewriteEnvironments(v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, path)
 	}
 
-	if r.Environments != nil {
-		//TODO: add a command line notice that this is a deprecated option.
-		r.Targets = r.Environments
+	// Normalize dynamic configuration tree according to configuration type.
+	v, diags := convert.Normalize(r, v)
+
+	// Keep track of diagnostics (warnings and errors in the schema).
+	r.diags = r.diags.Extend(diags)
+	if err := diags.AsError(); err != nil {
+		return nil, err
 	}
 
-	r.Path = filepath.Dir(path)
-	r.SetConfigFilePath(path)
+	// Convert normalized configuration tree to typed configuration.
+	err = r.updateWithDynamicValue(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", path, err)
+	}
 
 	_, err = r.Resources.VerifyUniqueResourceIdentifiers()
 	return &r, err
 }
 
-// SetConfigFilePath configures the path that its configuration
-// was loaded from in configuration leafs that require it.
-func (r *Root) SetConfigFilePath(path string) {
-	r.Resources.SetConfigFilePath(path)
-	if r.Artifacts != nil {
-		r.Artifacts.SetConfigFilePath(path)
+// rewriteEnvironments renames the deprecated "environments" section to "targets".
+func rewriteEnvironments(v dyn.Value) (dyn.Value, error) {
+	m, ok := v.AsMap()
+	if !ok {
+		return v, nil
 	}
 
-	if r.Targets != nil {
-		for _, env := range r.Targets {
-			if env == nil {
-				continue
-			}
-			if env.Resources != nil {
-				env.Resources.SetConfigFilePath(path)
-			}
-			if env.Artifacts != nil {
-				env.Artifacts.SetConfigFilePath(path)
-			}
-		}
+	environments, ok := m["environments"]
+	if !ok {
+		return v, nil
+	}
+
+	if _, ok := m["targets"]; ok {
+		return dyn.NilValue, fmt.Errorf("both 'environments' and 'targets' are specified, only 'targets' should be used")
+	}
+
+	//TODO: add a command line notice that this is a deprecated option.
+	out := make(map[string]dyn.Value, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	delete(out, "environments")
+	out["targets"] = environments
+	return dyn.NewValue(out, v.Location()), nil
+}
+
+// Diagnostics returns the diagnostics (e.g. unknown fields) that were
+// collected while loading the configuration and its includes.
+func (r *Root) Diagnostics() diag.Diagnostics {
+	return r.diags
+}
+
+// Value returns the dynamic configuration tree that reflects the typed configuration.
+// Values retain the location they were loaded from, where possible.
+func (r *Root) Value() (dyn.Value, error) {
+	ref := r.value
+	if !ref.IsValid() {
+		// The configuration was not loaded from a file (e.g. it is a struct literal in a test).
+		ref = dyn.NilValue
+	}
+	return convert.FromTyped(r, ref)
+}
+
+// Mutate applies the specified function to the dynamic configuration tree
+// and updates the typed configuration to reflect the result.
+func (r *Root) Mutate(fn func(dyn.Value) (dyn.Value, error)) error {
+	v, err := r.Value()
+	if err != nil {
+		return err
+	}
+
+	nv, err := fn(v)
+	if err != nil {
+		return err
 	}
+
+	return r.updateWithDynamicValue(nv)
+}
+
+// updateWithDynamicValue replaces the typed configuration with the specified
+// dynamic configuration tree. Fields that are not part of the dynamic configuration
+// tree (they are not serialized) are retained.
+func (r *Root) updateWithDynamicValue(nv dyn.Value) error {
+	var typed Root
+	err := convert.ToTyped(&typed, nv)
+	if err != nil {
+		return err
+	}
+
+	typed.value = nv
+	typed.diags = r.diags
+	typed.Path = r.Path
+	typed.Bundle.Git.Inferred = r.Bundle.Git.Inferred
+	typed.Bundle.Git.ActualBranch = r.Bundle.Git.ActualBranch
+	typed.Resources.ConfigureConfigFilePath(nv.Get("resources"), &r.Resources)
+	typed.Artifacts.ConfigureConfigFilePath(nv.Get("artifacts"), r.Artifacts)
+	typed.Artifacts.retainRemotePaths(r.Artifacts)
+	*r = typed
+	return nil
 }
 
 // Initializes variables using values passed from the command line flag
@@ -138,8 +220,47 @@ func (r *Root) InitializeVariables(vars []string) error {
 	return nil
 }
 
+// Initializes variables using values from JSON or YAML files passed through the
+// `--var-file` flag. Values in later files take precedence over values in earlier
+// files. Variables that have already been assigned a value are left untouched,
+// such that values passed through the `--var` flag take precedence.
+func (r *Root) InitializeVariablesFromFiles(paths []string) error {
+	assigned := make(map[string]bool)
+	for i := len(paths) - 1; i >= 0; i-- {
+		values, err := variable.LoadFile(paths[i])
+		if err != nil {
+			return err
+		}
+
+		for name, v := range values {
+			if _, ok := r.Variables[name]; !ok {
+				return fmt.Errorf("%s: variable %s has not been defined", v.Location(), name)
+			}
+			if assigned[name] || r.Variables[name].HasValue() {
+				continue
+			}
+			err := r.Variables[name].SetValue(v.AsAny())
+			if err != nil {
+				return fmt.Errorf("%s: failed to assign value to %s: %w", v.Location(), name, err)
+			}
+			assigned[name] = true
+		}
+	}
+	return nil
+}
+
 func (r *Root) Merge(other *Root) error {
-	err := r.Sync.Merge(r, other)
+	// Merge diagnostics.
+	r.diags = r.diags.Extend(other.diags)
+
+	// Check for safe merge, protecting against duplicate resource identifiers
+	err := r.Resources.VerifySafeMerge(&other.Resources)
+	if err != nil {
+		return err
+	}
+
+	// Sync paths are relative to the configuration file that defines them.
+	err = r.Sync.Merge(r, other)
 	if err != nil {
 		return err
 	}
@@ -148,106 +269,213 @@ func (r *Root) Merge(other *Root) error {
 	// TODO: when hooking into merge semantics, disallow setting path on the target instance.
 	other.Path = ""
 
-	// Check for safe merge, protecting against duplicate resource identifiers
-	err = r.Resources.VerifySafeMerge(&other.Resources)
+	ov, err := other.Value()
 	if err != nil {
 		return err
 	}
 
-	// TODO: define and test semantics for merging.
-	return mergo.Merge(r, other, mergo.WithOverride)
+	// Merge dynamic configuration values.
+	return r.Mutate(func(v dyn.Value) (dyn.Value, error) {
+		return merge.Merge(v, ov)
+	})
 }
 
-func (r *Root) MergeTargetOverrides(target *Target) error {
-	var err error
+// setKey returns a copy of the map value with the specified key set.
+// If the value is nil, a new map is created.
+func setKey(v dyn.Value, key string, nv dyn.Value) (dyn.Value, error) {
+	var out = make(map[string]dyn.Value)
+	switch v.Kind() {
+	case dyn.KindMap:
+		for k, v := range v.MustMap() {
+			out[k] = v
+		}
+	case dyn.KindNil:
+	default:
+		return dyn.NilValue, fmt.Errorf("%s: expected a map, found %s", v.Location(), v.Kind())
+	}
 
-	// Target may be nil if it's empty.
-	if target == nil {
-		return nil
+	out[key] = nv
+	return dyn.NewValue(out, v.Location()), nil
+}
+
+// mergeField merges the field with the specified name in the target
+// into the field with the same name in the root.
+func mergeField(root, target dyn.Value, name string) (dyn.Value, error) {
+	tv := target.Get(name)
+	if tv == dyn.NilValue {
+		return root, nil
 	}
 
-	if target.Bundle != nil {
-		err = mergo.Merge(&r.Bundle, target.Bundle, mergo.WithOverride)
-		if err != nil {
-			return err
-		}
+	nv, err := merge.Merge(root.Get(name), tv)
+	if err != nil {
+		return dyn.NilValue, fmt.Errorf("%s: %w", tv.Location(), err)
 	}
 
-	if target.Workspace != nil {
-		err = mergo.Merge(&r.Workspace, target.Workspace, mergo.WithOverride)
-		if err != nil {
-			return err
+	return setKey(root, name, nv)
+}
+
+// mergeVariable overrides the value of a variable definition with the value
+// specified in a target. We only allow overrides of the default value, the lookup or the secret.
+// For variables that are not maps or sequences, the target may specify either
+// as a map with a "default", "lookup" or "secret" key. Otherwise it specifies the default.
+func mergeVariable(def, v dyn.Value) (dyn.Value, error) {
+	var typ variable.VariableType
+	if t := def.Get("type"); t.Kind() == dyn.KindString {
+		typ = variable.VariableType(t.MustString())
+	}
+
+	key := "default"
+	if m, ok := v.AsMap(); ok && !typ.IsComplex() {
+		if lookup, ok := m["lookup"]; ok {
+			key, v = "lookup", lookup
+		} else if secret, ok := m["secret"]; ok {
+			key, v = "secret", secret
+		} else if dv, ok := m["default"]; ok {
+			v = dv
 		}
 	}
 
-	if target.Artifacts != nil {
-		err = mergo.Merge(&r.Artifacts, target.Artifacts, mergo.WithOverride, mergo.WithAppendSlice)
-		if err != nil {
-			return err
+	// The default value, lookup and secret are mutually exclusive.
+	if dm, ok := def.AsMap(); ok {
+		out := make(map[string]dyn.Value)
+		for k, dv := range dm {
+			if k != "default" && k != "lookup" && k != "secret" {
+				out[k] = dv
+			}
 		}
+		def = dyn.NewValue(out, def.Location())
 	}
 
-	if target.Resources != nil {
-		err = mergo.Merge(&r.Resources, target.Resources, mergo.WithOverride, mergo.WithAppendSlice)
-		if err != nil {
-			return err
+	return setKey(def, key, v)
+}
+
+// mergeVariables overrides the default values of variables with the values specified in the target.
+// Targets can only override the default value of variables that are defined at the top level.
+func mergeVariables(root, target dyn.Value) (dyn.Value, error) {
+	tv := target.Get("variables")
+	if tv == dyn.NilValue {
+		return root, nil
+	}
+
+	tm, ok := tv.AsMap()
+	if !ok {
+		return dyn.NilValue, fmt.Errorf("%s: expected a map, found %s", tv.Location(), tv.Kind())
+	}
+
+	variables := root.Get("variables")
+	vm, _ := variables.AsMap()
+	for k, v := range tm {
+		rv, ok := vm[k]
+		if !ok {
+			return dyn.NilValue, fmt.Errorf("%s: variable %s is not defined but is assigned a value", v.Location(), k)
 		}
 
-		err = r.Resources.Merge()
+		nv, err := mergeVariable(rv, v)
 		if err != nil {
-			return err
+			return dyn.NilValue, err
 		}
-	}
 
-	if target.Variables != nil {
-		for k, v := range target.Variables {
-			variable, ok := r.Variables[k]
-			if !ok {
-				return fmt.Errorf("variable %s is not defined but is assigned a value", k)
-			}
-			// we only allow overrides of the default value for a variable
-			defaultVal := v
-			variable.Default = &defaultVal
+		variables, err = setKey(variables, k, nv)
+		if err != nil {
+			return dyn.NilValue, err
 		}
 	}
 
-	if target.RunAs != nil {
-		r.RunAs = target.RunAs
+	return setKey(root, "variables", variables)
+}
+
+// MergeTargetOverrides merges the configuration of the target with the specified name
+// into the root configuration.
+func (r *Root) MergeTargetOverrides(name string) error {
+	root, err := r.Value()
+	if err != nil {
+		return err
 	}
 
-	if target.Mode != "" {
-		r.Bundle.Mode = target.Mode
+	// Target may be nil if it's empty.
+	target := root.Get("targets").Get(name)
+	if target == dyn.NilValue {
+		return nil
 	}
 
-	if target.ComputeID != "" {
-		r.Bundle.ComputeID = target.ComputeID
+	// Merge fields that can be merged 1:1.
+	for _, f := range []string{
+		"bundle",
+		"workspace",
+		"artifacts",
+		"resources",
+		"sync",
+		"permissions",
+	} {
+		if root, err = mergeField(root, target, f); err != nil {
+			return err
+		}
 	}
 
-	git := &r.Bundle.Git
-	if target.Git.Branch != "" {
-		git.Branch = target.Git.Branch
-		git.Inferred = false
+	if root, err = mergeVariables(root, target); err != nil {
+		return err
 	}
-	if target.Git.Commit != "" {
-		git.Commit = target.Git.Commit
+
+	// Merge `run_as`. This field must be overwritten if set, not merged.
+	if v := target.Get("run_as"); v != dyn.NilValue {
+		if root, err = setKey(root, "run_as", v); err != nil {
+			return err
+		}
 	}
-	if target.Git.OriginURL != "" {
-		git.OriginURL = target.Git.OriginURL
+
+	// Fields below are set on the bundle key.
+	bundle := root.Get("bundle")
+	for _, f := range []string{
+		"mode",
+		"compute_id",
+	} {
+		if v := target.Get(f); v != dyn.NilValue {
+			if bundle, err = setKey(bundle, f, v); err != nil {
+				return err
+			}
+		}
 	}
 
-	if target.Sync != nil {
-		err = mergo.Merge(&r.Sync, target.Sync, mergo.WithAppendSlice)
-		if err != nil {
+	// Merge `git`, where only the branch, commit and origin URL can be overridden.
+	git := bundle.Get("git")
+	branch := target.Get("git").Get("branch")
+	for _, f := range []string{
+		"branch",
+		"commit",
+		"origin_url",
+	} {
+		if v := target.Get("git").Get(f); v != dyn.NilValue {
+			if git, err = setKey(git, f, v); err != nil {
+				return err
+			}
+		}
+	}
+	if git != dyn.NilValue {
+		if bundle, err = setKey(bundle, "git", git); err != nil {
 			return err
 		}
 	}
 
-	if target.Permissions != nil {
-		err = mergo.Merge(&r.Permissions, target.Permissions, mergo.WithAppendSlice)
-		if err != nil {
+	if bundle != dyn.NilValue {
+		if root, err = setKey(root, "bundle", bundle); err != nil {
 			return err
 		}
 	}
 
+	err = r.updateWithDynamicValue(root)
+	if err != nil {
+		return err
+	}
+
+	// The branch is no longer inferred if it is set explicitly.
+	if branch != dyn.NilValue {
+		r.Bundle.Git.Inferred = false
+	}
+
+	// Merge job clusters, tasks and pipeline clusters with the same key.
+	if target.Get("resources") != dyn.NilValue {
+		return r.Resources.Merge()
+	}
+
 	return nil
 }
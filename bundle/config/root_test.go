@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/libs/diag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -75,6 +76,42 @@ func TestRootMergeMap(t *testing.T) {
 	assert.Equal(t, &Workspace{Host: "bar", Profile: "profile"}, root.Targets["development"].Workspace)
 }
 
+func TestRootLoadInvalidFieldType(t *testing.T) {
+	_, err := Load("./testdata/invalid_field_type/databricks.yml")
+	assert.ErrorContains(t, err, `./testdata/invalid_field_type/databricks.yml:8:28: cannot parse "many" as an integer`)
+}
+
+func TestRootLoadUnknownField(t *testing.T) {
+	root, err := Load("./testdata/unknown_field/databricks.yml")
+	require.NoError(t, err)
+	assert.Equal(t, "foo", root.Resources.Jobs["foo"].Name)
+
+	diags := root.Diagnostics()
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "unknown field: nmae (did you mean name?)", diags[0].Summary)
+	assert.Equal(t, "./testdata/unknown_field/databricks.yml", diags[0].Location.File)
+}
+
+func TestRootMergeRetainsLocations(t *testing.T) {
+	root, err := Load("./testdata/duplicate_resource_name_in_subconfiguration/databricks.yml")
+	require.NoError(t, err)
+
+	// Merge a configuration that doesn't come from a file.
+	other := &Root{
+		Workspace: Workspace{
+			Host: "bar",
+		},
+	}
+	require.NoError(t, root.Merge(other))
+	assert.Equal(t, "bar", root.Workspace.Host)
+
+	v, err := root.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "./testdata/duplicate_resource_name_in_subconfiguration/databricks.yml", v.Get("resources").Get("jobs").Get("foo").Location().File)
+	assert.Equal(t, "./testdata/duplicate_resource_name_in_subconfiguration/databricks.yml", root.Resources.Jobs["foo"].ConfigFilePath)
+}
+
 func TestDuplicateIdOnLoadReturnsError(t *testing.T) {
 	_, err := Load("./testdata/duplicate_resource_names_in_root/databricks.yml")
 	assert.ErrorContains(t, err, "multiple resources named foo (job at ./testdata/duplicate_resource_names_in_root/databricks.yml, pipeline at ./testdata/duplicate_resource_names_in_root/databricks.yml)")
@@ -96,7 +133,7 @@ func TestInitializeVariables(t *testing.T) {
 	root := &Root{
 		Variables: map[string]*variable.Variable{
 			"foo": {
-				Default:     &fooDefault,
+				Default:     fooDefault,
 				Description: "an optional variable since default is defined",
 			},
 			"bar": {
@@ -107,8 +144,8 @@ func TestInitializeVariables(t *testing.T) {
 
 	err := root.InitializeVariables([]string{"foo=123", "bar=456"})
 	assert.NoError(t, err)
-	assert.Equal(t, "123", *(root.Variables["foo"].Value))
-	assert.Equal(t, "456", *(root.Variables["bar"].Value))
+	assert.Equal(t, "123", root.Variables["foo"].Value)
+	assert.Equal(t, "456", root.Variables["bar"].Value)
 }
 
 func TestInitializeVariablesWithAnEqualSignInValue(t *testing.T) {
@@ -122,7 +159,7 @@ func TestInitializeVariablesWithAnEqualSignInValue(t *testing.T) {
 
 	err := root.InitializeVariables([]string{"foo=123=567"})
 	assert.NoError(t, err)
-	assert.Equal(t, "123=567", *(root.Variables["foo"].Value))
+	assert.Equal(t, "123=567", root.Variables["foo"].Value)
 }
 
 func TestInitializeVariablesInvalidFormat(t *testing.T) {
@@ -154,8 +191,123 @@ func TestInitializeVariablesUndefinedVariables(t *testing.T) {
 func TestRootMergeTargetOverridesWithMode(t *testing.T) {
 	root := &Root{
 		Bundle: Bundle{},
+		Targets: map[string]*Target{
+			"development": {Mode: Development},
+		},
 	}
-	env := &Target{Mode: Development}
-	require.NoError(t, root.MergeTargetOverrides(env))
+	require.NoError(t, root.MergeTargetOverrides("development"))
 	assert.Equal(t, Development, root.Bundle.Mode)
 }
+
+func TestRootMergeTargetOverridesVariableNotDefined(t *testing.T) {
+	root := &Root{
+		Targets: map[string]*Target{
+			"development": {
+				Variables: map[string]any{
+					"foo": "bar",
+				},
+			},
+		},
+	}
+	err := root.MergeTargetOverrides("development")
+	assert.ErrorContains(t, err, "variable foo is not defined but is assigned a value")
+}
+
+func TestRootMergeTargetOverridesVariableLookup(t *testing.T) {
+	root := &Root{
+		Variables: map[string]*variable.Variable{
+			"policy": {
+				Default: "1234",
+			},
+			"warehouse": {
+				Lookup: &variable.Lookup{
+					Warehouse: "Shared",
+				},
+			},
+		},
+		Targets: map[string]*Target{
+			"development": {
+				Variables: map[string]any{
+					"policy": map[string]any{
+						"lookup": map[string]any{
+							"cluster_policy": "Team Default",
+						},
+					},
+					"warehouse": "abcd",
+				},
+			},
+		},
+	}
+
+	require.NoError(t, root.MergeTargetOverrides("development"))
+	assert.Nil(t, root.Variables["policy"].Default)
+	assert.Equal(t, &variable.Lookup{ClusterPolicy: "Team Default"}, root.Variables["policy"].Lookup)
+	assert.Equal(t, "abcd", root.Variables["warehouse"].Default)
+	assert.Nil(t, root.Variables["warehouse"].Lookup)
+}
+
+func TestRootMergeTargetOverridesVariableSecret(t *testing.T) {
+	root := &Root{
+		Variables: map[string]*variable.Variable{
+			"token": {
+				Default:   "dev-token",
+				Sensitive: true,
+			},
+		},
+		Targets: map[string]*Target{
+			"production": {
+				Variables: map[string]any{
+					"token": map[string]any{
+						"secret": map[string]any{
+							"scope": "ci",
+							"key":   "token",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, root.MergeTargetOverrides("production"))
+	assert.Nil(t, root.Variables["token"].Default)
+	assert.Equal(t, &variable.Secret{Scope: "ci", Key: "token"}, root.Variables["token"].Secret)
+	assert.True(t, root.Variables["token"].Sensitive)
+}
+
+func TestInitializeVariablesFromFiles(t *testing.T) {
+	root := &Root{
+		Variables: map[string]*variable.Variable{
+			"foo":   {},
+			"bar":   {},
+			"baz":   {},
+			"count": {Type: variable.VariableTypeInt},
+		},
+	}
+
+	err := root.InitializeVariables([]string{"foo=from-flag"})
+	require.NoError(t, err)
+
+	err = root.InitializeVariablesFromFiles([]string{
+		"./testdata/variable_files/first.yml",
+		"./testdata/variable_files/second.json",
+	})
+	require.NoError(t, err)
+
+	// Values passed through --var take precedence.
+	assert.Equal(t, "from-flag", root.Variables["foo"].Value)
+	// Values in later files take precedence.
+	assert.Equal(t, "from-second", root.Variables["bar"].Value)
+	assert.Equal(t, int64(5), root.Variables["count"].Value)
+	assert.False(t, root.Variables["baz"].HasValue())
+}
+
+func TestInitializeVariablesFromFilesUndefinedVariable(t *testing.T) {
+	root := &Root{
+		Variables: map[string]*variable.Variable{
+			"foo": {},
+		},
+	}
+
+	err := root.InitializeVariablesFromFiles([]string{"./testdata/variable_files/undefined.json"})
+	assert.ErrorContains(t, err, "./testdata/variable_files/undefined.json:2:10: variable qux has not been defined")
+}
@@ -33,7 +33,7 @@ type Target struct {
 	// Override default values for defined variables
 	// Does not permit defining new variables or redefining existing ones
 	// in the scope of an target
-	Variables map[string]string `json:"variables,omitempty"`
+	Variables map[string]any `json:"variables,omitempty"`
 
 	Git Git `json:"git,omitempty"`
 
@@ -0,0 +1,35 @@
+package variable
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/databricks/cli/libs/config"
+	"github.com/databricks/cli/libs/config/yamlloader"
+)
+
+// LoadFile loads variable values from a JSON or YAML file.
+// The file must contain a map from variable names to their values.
+// A value can be of any type, as long as it matches the type of the variable.
+func LoadFile(path string) (map[string]config.Value, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// YAML is a superset of JSON, so the YAML loader handles both.
+	v, err := yamlloader.LoadYAML(path, f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load variables from %s: %w", path, err)
+	}
+
+	switch v.Kind() {
+	case config.KindNil:
+		return nil, nil
+	case config.KindMap:
+		return v.MustMap(), nil
+	default:
+		return nil, fmt.Errorf("%s: expected a map of variable names to values, found %s", v.Location(), v.Kind())
+	}
+}
@@ -0,0 +1,30 @@
+package variable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFileYAML(t *testing.T) {
+	values, err := LoadFile("./testdata/variables.yml")
+	require.NoError(t, err)
+	require.Len(t, values, 3)
+	assert.Equal(t, "bar", values["foo"].AsAny())
+	assert.Equal(t, 3, values["count"].AsAny())
+	assert.Equal(t, map[string]any{"team": "data"}, values["tags"].AsAny())
+}
+
+func TestLoadFileJSON(t *testing.T) {
+	values, err := LoadFile("./testdata/variables.json")
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+	assert.Equal(t, "baz", values["foo"].AsAny())
+	assert.Equal(t, []any{"a@example.com"}, values["emails"].AsAny())
+}
+
+func TestLoadFileInvalid(t *testing.T) {
+	_, err := LoadFile("./testdata/invalid.json")
+	assert.ErrorContains(t, err, "expected a map of variable names to values, found sequence")
+}
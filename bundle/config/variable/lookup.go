@@ -0,0 +1,185 @@
+package variable
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+)
+
+// Lookup resolves the name of a workspace object to its ID.
+// Exactly one of its fields must be set.
+type Lookup struct {
+	// Name of a cluster; resolves to its cluster ID.
+	Cluster string `json:"cluster,omitempty"`
+
+	// Name of a cluster policy; resolves to its policy ID.
+	ClusterPolicy string `json:"cluster_policy,omitempty"`
+
+	// Name of an instance pool; resolves to its instance pool ID.
+	InstancePool string `json:"instance_pool,omitempty"`
+
+	// Display name of a service principal; resolves to its application ID.
+	ServicePrincipal string `json:"service_principal,omitempty"`
+
+	// Name of a SQL warehouse; resolves to its warehouse ID.
+	Warehouse string `json:"warehouse,omitempty"`
+}
+
+// Maximum number of candidates to include in the error for a name that is not found.
+const maxLookupCandidates = 20
+
+// lookupCandidate is a workspace object that a lookup may resolve to.
+type lookupCandidate struct {
+	name string
+	id   string
+}
+
+type lookupResolver struct {
+	// Singular and plural description of the object type, used in error messages.
+	singular string
+	plural   string
+
+	// Name to look up.
+	name string
+
+	// List all candidate objects in the workspace.
+	list func(ctx context.Context, w *databricks.WorkspaceClient) ([]lookupCandidate, error)
+}
+
+func (l *Lookup) resolvers() []lookupResolver {
+	var out []lookupResolver
+	if l.Cluster != "" {
+		out = append(out, lookupResolver{"cluster", "clusters", l.Cluster, listClusters})
+	}
+	if l.ClusterPolicy != "" {
+		out = append(out, lookupResolver{"cluster policy", "cluster policies", l.ClusterPolicy, listClusterPolicies})
+	}
+	if l.InstancePool != "" {
+		out = append(out, lookupResolver{"instance pool", "instance pools", l.InstancePool, listInstancePools})
+	}
+	if l.ServicePrincipal != "" {
+		out = append(out, lookupResolver{"service principal", "service principals", l.ServicePrincipal, listServicePrincipals})
+	}
+	if l.Warehouse != "" {
+		out = append(out, lookupResolver{"warehouse", "warehouses", l.Warehouse, listWarehouses})
+	}
+	return out
+}
+
+// Resolve looks up the ID of the object referred to by name.
+// It returns an error listing the candidates if the name is not found or is ambiguous.
+func (l *Lookup) Resolve(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	resolvers := l.resolvers()
+	switch len(resolvers) {
+	case 0:
+		return "", fmt.Errorf("no lookup specified")
+	case 1:
+	default:
+		return "", fmt.Errorf("a lookup can only specify one object; found %d", len(resolvers))
+	}
+
+	r := resolvers[0]
+	candidates, err := r.list(ctx, w)
+	if err != nil {
+		return "", fmt.Errorf("failed to list %s: %w", r.plural, err)
+	}
+
+	var ids []string
+	var names []string
+	for _, c := range candidates {
+		if c.name == r.name {
+			ids = append(ids, c.id)
+		}
+		names = append(names, fmt.Sprintf("%q", c.name))
+	}
+
+	switch len(ids) {
+	case 0:
+		if len(names) == 0 {
+			return "", fmt.Errorf("%s %q not found; there are no %s in the workspace", r.singular, r.name, r.plural)
+		}
+		sort.Strings(names)
+		if len(names) > maxLookupCandidates {
+			names = append(names[:maxLookupCandidates], fmt.Sprintf("and %d more", len(names)-maxLookupCandidates))
+		}
+		return "", fmt.Errorf("%s %q not found; available %s: %s", r.singular, r.name, r.plural, strings.Join(names, ", "))
+	case 1:
+		return ids[0], nil
+	default:
+		sort.Strings(ids)
+		return "", fmt.Errorf("%s %q is ambiguous; it matches %d %s with IDs: %s", r.singular, r.name, len(ids), r.plural, strings.Join(ids, ", "))
+	}
+}
+
+func (l *Lookup) String() string {
+	for _, r := range l.resolvers() {
+		return fmt.Sprintf("%s %q", r.singular, r.name)
+	}
+	return ""
+}
+
+func listClusters(ctx context.Context, w *databricks.WorkspaceClient) ([]lookupCandidate, error) {
+	clusters, err := w.Clusters.ListAll(ctx, compute.ListClustersRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var out []lookupCandidate
+	for _, c := range clusters {
+		out = append(out, lookupCandidate{c.ClusterName, c.ClusterId})
+	}
+	return out, nil
+}
+
+func listClusterPolicies(ctx context.Context, w *databricks.WorkspaceClient) ([]lookupCandidate, error) {
+	policies, err := w.ClusterPolicies.ListAll(ctx, compute.ListClusterPoliciesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var out []lookupCandidate
+	for _, p := range policies {
+		out = append(out, lookupCandidate{p.Name, p.PolicyId})
+	}
+	return out, nil
+}
+
+func listInstancePools(ctx context.Context, w *databricks.WorkspaceClient) ([]lookupCandidate, error) {
+	pools, err := w.InstancePools.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []lookupCandidate
+	for _, p := range pools {
+		out = append(out, lookupCandidate{p.InstancePoolName, p.InstancePoolId})
+	}
+	return out, nil
+}
+
+func listServicePrincipals(ctx context.Context, w *databricks.WorkspaceClient) ([]lookupCandidate, error) {
+	sps, err := w.ServicePrincipals.ListAll(ctx, iam.ListServicePrincipalsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var out []lookupCandidate
+	for _, sp := range sps {
+		out = append(out, lookupCandidate{sp.DisplayName, sp.ApplicationId})
+	}
+	return out, nil
+}
+
+func listWarehouses(ctx context.Context, w *databricks.WorkspaceClient) ([]lookupCandidate, error) {
+	warehouses, err := w.Warehouses.ListAll(ctx, sql.ListWarehousesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var out []lookupCandidate
+	for _, wh := range warehouses {
+		out = append(out, lookupCandidate{wh.Name, wh.Id})
+	}
+	return out, nil
+}
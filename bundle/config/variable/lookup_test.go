@@ -0,0 +1,90 @@
+package variable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/qa"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clusterPoliciesFixture(t *testing.T, policies ...compute.Policy) (*databricks.WorkspaceClient, func()) {
+	cfg, server := qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/policies/clusters/list?",
+			Response: compute.ListPoliciesResponse{
+				Policies: policies,
+			},
+		},
+	}.Config(t)
+	w := databricks.Must(databricks.NewWorkspaceClient((*databricks.Config)(cfg)))
+	return w, server.Close
+}
+
+func TestLookupClusterPolicy(t *testing.T) {
+	w, close := clusterPoliciesFixture(t,
+		compute.Policy{Name: "Team Default", PolicyId: "1234"},
+		compute.Policy{Name: "Personal Compute", PolicyId: "5678"},
+	)
+	defer close()
+
+	l := &Lookup{ClusterPolicy: "Team Default"}
+	id, err := l.Resolve(context.Background(), w)
+	require.NoError(t, err)
+	assert.Equal(t, "1234", id)
+}
+
+func TestLookupClusterPolicyNotFound(t *testing.T) {
+	w, close := clusterPoliciesFixture(t,
+		compute.Policy{Name: "Team Default", PolicyId: "1234"},
+		compute.Policy{Name: "Personal Compute", PolicyId: "5678"},
+	)
+	defer close()
+
+	l := &Lookup{ClusterPolicy: "Team Defualt"}
+	_, err := l.Resolve(context.Background(), w)
+	assert.EqualError(t, err, `cluster policy "Team Defualt" not found; available cluster policies: "Personal Compute", "Team Default"`)
+}
+
+func TestLookupClusterPolicyAmbiguous(t *testing.T) {
+	w, close := clusterPoliciesFixture(t,
+		compute.Policy{Name: "Team Default", PolicyId: "5678"},
+		compute.Policy{Name: "Team Default", PolicyId: "1234"},
+	)
+	defer close()
+
+	l := &Lookup{ClusterPolicy: "Team Default"}
+	_, err := l.Resolve(context.Background(), w)
+	assert.EqualError(t, err, `cluster policy "Team Default" is ambiguous; it matches 2 cluster policies with IDs: 1234, 5678`)
+}
+
+func TestLookupInstancePool(t *testing.T) {
+	cfg, server := qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/instance-pools/list",
+			Response: compute.ListInstancePools{
+				InstancePools: []compute.InstancePoolAndStats{
+					{InstancePoolName: "pool", InstancePoolId: "pool-id"},
+				},
+			},
+		},
+	}.Config(t)
+	defer server.Close()
+	w := databricks.Must(databricks.NewWorkspaceClient((*databricks.Config)(cfg)))
+
+	l := &Lookup{InstancePool: "pool"}
+	id, err := l.Resolve(context.Background(), w)
+	require.NoError(t, err)
+	assert.Equal(t, "pool-id", id)
+}
+
+func TestLookupMultipleObjects(t *testing.T) {
+	l := &Lookup{Cluster: "foo", Warehouse: "bar"}
+	_, err := l.Resolve(context.Background(), nil)
+	assert.EqualError(t, err, "a lookup can only specify one object; found 2")
+}
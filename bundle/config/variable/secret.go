@@ -0,0 +1,72 @@
+package variable
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+
+	"github.com/databricks/cli/libs/log"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+)
+
+// Secret refers to a secret in a Databricks secret scope.
+type Secret struct {
+	// Name of the secret scope.
+	Scope string `json:"scope"`
+
+	// Key of the secret in the scope.
+	Key string `json:"key"`
+}
+
+// Resolve reads the value of the secret.
+func (s *Secret) Resolve(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	if s.Scope == "" || s.Key == "" {
+		return "", fmt.Errorf("a secret must specify both a scope and a key")
+	}
+
+	// The SDK logs response bodies at the debug level, which would include the
+	// value of the secret. Only forward records at the info level or above.
+	ctx = log.NewContext(ctx, slog.New(&minLevelHandler{
+		Handler: log.GetLogger(ctx).Handler(),
+		level:   log.LevelInfo,
+	}))
+
+	res, err := w.Secrets.GetSecret(ctx, workspace.GetSecretRequest{
+		Scope: s.Scope,
+		Key:   s.Key,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret %s: %w", s, err)
+	}
+
+	value, err := base64.StdEncoding.DecodeString(res.Value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret %s: %w", s, err)
+	}
+	return string(value), nil
+}
+
+func (s *Secret) String() string {
+	return fmt.Sprintf("%q in scope %q", s.Key, s.Scope)
+}
+
+// minLevelHandler drops records below a minimum level.
+type minLevelHandler struct {
+	slog.Handler
+	level slog.Level
+}
+
+func (h *minLevelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level && h.Handler.Enabled(ctx, level)
+}
+
+func (h *minLevelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &minLevelHandler{Handler: h.Handler.WithAttrs(attrs), level: h.level}
+}
+
+func (h *minLevelHandler) WithGroup(name string) slog.Handler {
+	return &minLevelHandler{Handler: h.Handler.WithGroup(name), level: h.level}
+}
@@ -0,0 +1,52 @@
+package variable
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/qa"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretResolve(t *testing.T) {
+	cfg, server := qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/secrets/get?key=token&scope=ci",
+			Response: workspace.GetSecretResponse{
+				Key:   "token",
+				Value: base64.StdEncoding.EncodeToString([]byte("s3cr3t")),
+			},
+		},
+	}.Config(t)
+	defer server.Close()
+	w := databricks.Must(databricks.NewWorkspaceClient((*databricks.Config)(cfg)))
+
+	s := &Secret{Scope: "ci", Key: "token"}
+	value, err := s.Resolve(context.Background(), w)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", value)
+}
+
+func TestSecretResolveRequiresScopeAndKey(t *testing.T) {
+	s := &Secret{Scope: "ci"}
+	_, err := s.Resolve(context.Background(), nil)
+	assert.EqualError(t, err, "a secret must specify both a scope and a key")
+}
+
+func TestVariableIsSensitive(t *testing.T) {
+	assert.False(t, (&Variable{}).IsSensitive())
+	assert.True(t, (&Variable{Sensitive: true}).IsSensitive())
+	assert.True(t, (&Variable{Secret: &Secret{Scope: "ci", Key: "token"}}).IsSensitive())
+}
+
+func TestSetValueAlreadyAssignedSensitive(t *testing.T) {
+	v := &Variable{Sensitive: true}
+	require.NoError(t, v.Set("s3cr3t"))
+	err := v.Set("other")
+	assert.EqualError(t, err, "variable has already been assigned a value")
+}
@@ -0,0 +1,136 @@
+package variable
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// VariableType is the type of a variable.
+type VariableType string
+
+const (
+	VariableTypeString VariableType = "string"
+	VariableTypeBool   VariableType = "bool"
+	VariableTypeInt    VariableType = "int"
+	VariableTypeList   VariableType = "list"
+	VariableTypeMap    VariableType = "map"
+	VariableTypeObject VariableType = "object"
+)
+
+// IsValid returns true if the type is known. The empty type is a string.
+func (t VariableType) IsValid() bool {
+	switch t {
+	case "", VariableTypeString, VariableTypeBool, VariableTypeInt, VariableTypeList, VariableTypeMap, VariableTypeObject:
+		return true
+	}
+	return false
+}
+
+// IsComplex returns true if values of this type cannot be embedded in a string.
+func (t VariableType) IsComplex() bool {
+	switch t {
+	case VariableTypeList, VariableTypeMap, VariableTypeObject:
+		return true
+	}
+	return false
+}
+
+func (t VariableType) String() string {
+	if t == "" {
+		return string(VariableTypeString)
+	}
+	return string(t)
+}
+
+// Parse parses a value of this type from a string.
+// Values of complex types are expected to be encoded as JSON.
+func (t VariableType) Parse(s string) (any, error) {
+	switch t {
+	case "", VariableTypeString:
+		return s, nil
+	case VariableTypeBool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q as a bool", s)
+		}
+		return b, nil
+	case VariableTypeInt:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q as an int", s)
+		}
+		return i, nil
+	case VariableTypeList, VariableTypeMap, VariableTypeObject:
+		var out any
+		err := json.Unmarshal([]byte(s), &out)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse value as JSON for variable of type %s: %w", t, err)
+		}
+		return t.Convert(normalizeJSON(out))
+	}
+	return nil, fmt.Errorf("unknown variable type: %s", t)
+}
+
+// Convert checks that the value is valid for this type and converts it if necessary.
+// Scalar values are converted to strings for variables of type string, for compatibility
+// with the configuration format where `default: 1` was interpreted as a string.
+func (t VariableType) Convert(v any) (any, error) {
+	switch t {
+	case "", VariableTypeString:
+		switch vv := v.(type) {
+		case string:
+			return vv, nil
+		case bool, int, int64, float64:
+			return fmt.Sprint(vv), nil
+		}
+	case VariableTypeBool:
+		switch vv := v.(type) {
+		case bool:
+			return vv, nil
+		case string:
+			return t.Parse(vv)
+		}
+	case VariableTypeInt:
+		switch vv := v.(type) {
+		case int:
+			return int64(vv), nil
+		case int64:
+			return vv, nil
+		case string:
+			return t.Parse(vv)
+		}
+	case VariableTypeList:
+		if vv, ok := v.([]any); ok {
+			return vv, nil
+		}
+	case VariableTypeMap, VariableTypeObject:
+		if vv, ok := v.(map[string]any); ok {
+			return vv, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown variable type: %s", t)
+	}
+
+	return nil, fmt.Errorf("expected a value of type %s, found %T", t, v)
+}
+
+// normalizeJSON converts numbers decoded from JSON to int64 where possible,
+// consistent with how integers are represented in the configuration tree.
+func normalizeJSON(v any) any {
+	switch vv := v.(type) {
+	case map[string]any:
+		for k, e := range vv {
+			vv[k] = normalizeJSON(e)
+		}
+	case []any:
+		for i, e := range vv {
+			vv[i] = normalizeJSON(e)
+		}
+	case float64:
+		if vv == float64(int64(vv)) {
+			return int64(vv)
+		}
+	}
+	return v
+}
@@ -0,0 +1,62 @@
+package variable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariableTypeParse(t *testing.T) {
+	v, err := VariableTypeString.Parse("123")
+	require.NoError(t, err)
+	assert.Equal(t, "123", v)
+
+	v, err = VariableTypeBool.Parse("true")
+	require.NoError(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = VariableTypeInt.Parse("123")
+	require.NoError(t, err)
+	assert.Equal(t, int64(123), v)
+
+	v, err = VariableTypeList.Parse(`["a", 1]`)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a", int64(1)}, v)
+
+	v, err = VariableTypeObject.Parse(`{"num_workers": 2}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"num_workers": int64(2)}, v)
+
+	_, err = VariableTypeInt.Parse("abc")
+	assert.ErrorContains(t, err, `cannot parse "abc" as an int`)
+
+	_, err = VariableTypeMap.Parse(`["a"]`)
+	assert.ErrorContains(t, err, "expected a value of type map, found []interface {}")
+}
+
+func TestVariableTypeConvert(t *testing.T) {
+	// Scalars are converted to strings for variables without a type.
+	v, err := VariableType("").Convert(int64(1))
+	require.NoError(t, err)
+	assert.Equal(t, "1", v)
+
+	_, err = VariableTypeString.Convert(map[string]any{})
+	assert.ErrorContains(t, err, "expected a value of type string")
+
+	_, err = VariableTypeList.Convert("abc")
+	assert.ErrorContains(t, err, "expected a value of type list, found string")
+
+	_, err = VariableType("number").Convert(1)
+	assert.ErrorContains(t, err, "unknown variable type: number")
+}
+
+func TestVariableSet(t *testing.T) {
+	v := Variable{Type: VariableTypeBool}
+	require.NoError(t, v.Set("false"))
+	assert.Equal(t, false, v.Value)
+	assert.True(t, v.HasValue())
+
+	err := v.Set("true")
+	assert.ErrorContains(t, err, "variable has already been assigned value: false")
+}
@@ -8,22 +8,53 @@ const VariableReferencePrefix = "var"
 
 // An input variable for the bundle config
 type Variable struct {
+	// Type of the variable. If not specified, the variable is a string.
+	// Variables of other types can be referenced anywhere a value of
+	// that type is valid, e.g. `new_cluster: ${var.my_cluster}`.
+	Type VariableType `json:"type,omitempty"`
+
 	// A default value which then makes the variable optional
-	Default *string `json:"default,omitempty"`
+	Default any `json:"default,omitempty"`
 
 	// Documentation for this input variable
 	Description string `json:"description,omitempty"`
 
+	// Resolves the value of the variable by looking up the ID of a workspace
+	// object by its name, e.g. `lookup: {cluster_policy: "Team Default"}`.
+	// A variable cannot have both a lookup and a default value.
+	Lookup *Lookup `json:"lookup,omitempty"`
+
+	// Resolves the value of the variable by reading a secret from a Databricks
+	// secret scope, e.g. `secret: {scope: "ci", key: "token"}`. Variables backed
+	// by a secret are always sensitive. A variable cannot have both a secret and
+	// a default value or lookup.
+	Secret *Secret `json:"secret,omitempty"`
+
+	// Name of a local environment variable to read the value of the variable from,
+	// e.g. `env: MY_TOKEN`. If the environment variable is not set, the variable
+	// falls back to its default value.
+	Env string `json:"env,omitempty"`
+
+	// Sensitive values are redacted in the output of `bundle validate`,
+	// in logs, and in the deployment metadata. Only variables of type
+	// string can be sensitive. Values that are used in resource definitions
+	// are not redacted in the generated Terraform configuration or in the
+	// Terraform state, both of which are stored in the bundle's state.
+	Sensitive bool `json:"sensitive,omitempty"`
+
 	// This field stores the resolved value for the variable. The variable are
 	// resolved in the following priority order (from highest to lowest)
 	//
 	// 1. Command line flag. For example: `--var="foo=bar"`
-	// 2. Target variable. eg: BUNDLE_VAR_foo=bar
-	// 3. Default value as defined in the applicable environments block
-	// 4. Default value defined in variable definition
-	// 5. Throw error, since if no default value is defined, then the variable
+	// 2. Variable file passed as command line flag. For example: `--var-file=vars.json`
+	// 3. Target variable. eg: BUNDLE_VAR_foo=bar
+	// 4. Variable file at `.databricks/bundle/<target>/variable-overrides.json`
+	// 5. Local environment variable named by `env`
+	// 6. Default value, lookup or secret as defined in the applicable environments block
+	// 7. Default value, lookup or secret defined in variable definition
+	// 8. Throw error, since if no default value is defined, then the variable
 	//    is required
-	Value *string `json:"value,omitempty" bundle:"readonly"`
+	Value any `json:"value,omitempty" bundle:"readonly"`
 }
 
 // True if the variable has been assigned a default value. Variables without a
@@ -32,15 +63,55 @@ func (v *Variable) HasDefault() bool {
 	return v.Default != nil
 }
 
+// True if the variable is resolved by looking up a workspace object
+func (v *Variable) HasLookup() bool {
+	return v.Lookup != nil
+}
+
+// True if the variable is resolved by reading a secret
+func (v *Variable) HasSecret() bool {
+	return v.Secret != nil
+}
+
+// True if the value of the variable must not be displayed or persisted.
+// Variables backed by a secret are always sensitive.
+func (v *Variable) IsSensitive() bool {
+	return v.Sensitive || v.HasSecret()
+}
+
 // True if variable has already been assigned a value
 func (v *Variable) HasValue() bool {
 	return v.Value != nil
 }
 
+// Set assigns a value specified as a string (e.g. through the `--var` flag
+// or an environment variable). The string is parsed according to the variable type.
 func (v *Variable) Set(val string) error {
+	parsed, err := v.Type.Parse(val)
+	if err != nil {
+		// The parse error includes the value.
+		if v.IsSensitive() {
+			return fmt.Errorf("cannot parse value as %s", v.Type)
+		}
+		return err
+	}
+	return v.SetValue(parsed)
+}
+
+// SetValue assigns a value to the variable. The value must be of the variable type.
+func (v *Variable) SetValue(val any) error {
 	if v.HasValue() {
-		return fmt.Errorf("variable has already been assigned value: %s", *v.Value)
+		if v.IsSensitive() {
+			return fmt.Errorf("variable has already been assigned a value")
+		}
+		return fmt.Errorf("variable has already been assigned value: %v", v.Value)
+	}
+
+	converted, err := v.Type.Convert(val)
+	if err != nil {
+		return err
 	}
-	v.Value = &val
+
+	v.Value = converted
 	return nil
 }
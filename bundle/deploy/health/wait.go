@@ -0,0 +1,243 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/retries"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+)
+
+type wait struct{}
+
+// Wait waits for the deployed resources to be healthy if this is enabled for the target.
+// Model serving endpoints must be ready, and continuous pipelines and jobs must be running.
+func Wait() bundle.Mutator {
+	return &wait{}
+}
+
+func (m *wait) Name() string {
+	return "health.Wait"
+}
+
+// check waits for a single resource to be healthy.
+type check struct {
+	key  string
+	wait func(ctx context.Context, w *databricks.WorkspaceClient, timeout time.Duration) error
+}
+
+func (m *wait) Apply(ctx context.Context, b *bundle.Bundle) error {
+	deployment := b.Config.Bundle.Deployment
+	if !deployment.IsWaitEnabled() {
+		return nil
+	}
+
+	timeout, err := deployment.WaitTimeout()
+	if err != nil {
+		return err
+	}
+
+	checks := collectChecks(b, time.Now())
+	if len(checks) == 0 {
+		return nil
+	}
+
+	cmdio.LogString(ctx, "Waiting for deployed resources to be healthy...")
+	w := b.WorkspaceClient()
+	deadline := time.Now().Add(timeout)
+	for _, c := range checks {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("%s is not healthy: timed out after %s", c.key, timeout)
+		}
+		err := c.wait(ctx, w, remaining)
+		if err != nil {
+			return fmt.Errorf("%s is not healthy: %w", c.key, err)
+		}
+		cmdio.LogString(ctx, fmt.Sprintf("%s is healthy", c.key))
+	}
+	return nil
+}
+
+func sortedKeys[T any](m map[string]*T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// collectChecks returns the checks for the deployed resources that are expected to be
+// running after a deployment. Runs of continuous jobs that started before the given
+// time do not fail the check; they may have been superseded by the deployment.
+func collectChecks(b *bundle.Bundle, since time.Time) []check {
+	r := b.Config.Resources
+	var out []check
+
+	for _, k := range sortedKeys(r.ModelServingEndpoints) {
+		endpoint := r.ModelServingEndpoints[k]
+		if endpoint == nil || endpoint.ID == "" {
+			continue
+		}
+		name := endpoint.ID
+		out = append(out, check{"model_serving_endpoints." + k, func(ctx context.Context, w *databricks.WorkspaceClient, timeout time.Duration) error {
+			return WaitForServingEndpoint(ctx, w, name, timeout, nil)
+		}})
+	}
+
+	for _, k := range sortedKeys(r.Pipelines) {
+		pipeline := r.Pipelines[k]
+		if pipeline == nil || pipeline.ID == "" || pipeline.PipelineSpec == nil || !pipeline.Continuous {
+			continue
+		}
+		id := pipeline.ID
+		out = append(out, check{"pipelines." + k, func(ctx context.Context, w *databricks.WorkspaceClient, timeout time.Duration) error {
+			return waitForPipeline(ctx, w, id, timeout)
+		}})
+	}
+
+	for _, k := range sortedKeys(r.Jobs) {
+		job := r.Jobs[k]
+		if job == nil || job.ID == "" || job.JobSettings == nil || job.Continuous == nil {
+			continue
+		}
+		if job.Continuous.PauseStatus == jobs.PauseStatusPaused {
+			continue
+		}
+		id := job.ID
+		out = append(out, check{"jobs." + k, func(ctx context.Context, w *databricks.WorkspaceClient, timeout time.Duration) error {
+			return waitForJob(ctx, w, id, since, timeout)
+		}})
+	}
+
+	return out
+}
+
+// endpointErrors returns the deployment messages of the served models that failed to deploy.
+func endpointErrors(endpoint *serving.ServingEndpointDetailed) []string {
+	var models []serving.ServedModelOutput
+	if endpoint.Config != nil {
+		models = append(models, endpoint.Config.ServedModels...)
+	}
+	if endpoint.PendingConfig != nil {
+		models = append(models, endpoint.PendingConfig.ServedModels...)
+	}
+
+	var out []string
+	for _, m := range models {
+		if m.State == nil || m.State.Deployment != serving.ServedModelStateDeploymentDeploymentFailed {
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s: %s", m.Name, m.State.DeploymentStateMessage))
+	}
+	return out
+}
+
+// WaitForServingEndpoint waits until the configuration of the endpoint is no longer
+// being updated and the endpoint is ready. If onState is not nil, it is called
+// every time the state of the endpoint changes.
+func WaitForServingEndpoint(ctx context.Context, w *databricks.WorkspaceClient, name string, timeout time.Duration, onState func(*serving.EndpointState)) error {
+	var prev *serving.EndpointState
+	_, err := retries.Poll[serving.ServingEndpointDetailed](ctx, timeout, func() (*serving.ServingEndpointDetailed, *retries.Err) {
+		endpoint, err := w.ServingEndpoints.GetByName(ctx, name)
+		if err != nil {
+			return nil, retries.Halt(err)
+		}
+
+		state := endpoint.State
+		if state == nil {
+			return nil, retries.Continues("endpoint state is not available")
+		}
+
+		if onState != nil && (prev == nil || *prev != *state) {
+			onState(state)
+			prev = state
+		}
+
+		switch {
+		case state.ConfigUpdate == serving.EndpointStateConfigUpdateUpdateFailed:
+			msg := fmt.Sprintf("failed to update the configuration of endpoint %s", name)
+			if errs := endpointErrors(endpoint); len(errs) > 0 {
+				msg += ": " + strings.Join(errs, "; ")
+			}
+			return nil, retries.Halt(errors.New(msg))
+		case state.ConfigUpdate == serving.EndpointStateConfigUpdateInProgress:
+			return nil, retries.Continues("the configuration of the endpoint is being updated")
+		case state.Ready != serving.EndpointStateReadyReady:
+			return nil, retries.Continues(fmt.Sprintf("endpoint is %s", state.Ready))
+		}
+		return endpoint, nil
+	})
+	return err
+}
+
+func waitForPipeline(ctx context.Context, w *databricks.WorkspaceClient, id string, timeout time.Duration) error {
+	_, err := retries.Poll[pipelines.GetPipelineResponse](ctx, timeout, func() (*pipelines.GetPipelineResponse, *retries.Err) {
+		pipeline, err := w.Pipelines.GetByPipelineId(ctx, id)
+		if err != nil {
+			return nil, retries.Halt(err)
+		}
+
+		switch pipeline.State {
+		case pipelines.PipelineStateRunning:
+			return pipeline, nil
+		case pipelines.PipelineStateFailed, pipelines.PipelineStateDeleted:
+			msg := fmt.Sprintf("pipeline %s is %s", id, pipeline.State)
+			if pipeline.Cause != "" {
+				msg += ": " + pipeline.Cause
+			}
+			return nil, retries.Halt(errors.New(msg))
+		}
+		return nil, retries.Continues(fmt.Sprintf("pipeline is %s", pipeline.State))
+	})
+	return err
+}
+
+func waitForJob(ctx context.Context, w *databricks.WorkspaceClient, id string, since time.Time, timeout time.Duration) error {
+	jobID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("job ID is not an integer: %s", id)
+	}
+
+	_, err = retries.Poll[jobs.BaseRun](ctx, timeout, func() (*jobs.BaseRun, *retries.Err) {
+		// Runs are listed in descending order by start time.
+		it := w.Jobs.ListRuns(ctx, jobs.ListRunsRequest{
+			JobId: jobID,
+			Limit: 1,
+		})
+		if !it.HasNext(ctx) {
+			return nil, retries.Continues("the job has not started a run yet")
+		}
+		run, err := it.Next(ctx)
+		if err != nil {
+			return nil, retries.Halt(err)
+		}
+
+		if run.State == nil {
+			return nil, retries.Continues("run state is not available")
+		}
+		switch run.State.LifeCycleState {
+		case jobs.RunLifeCycleStateRunning:
+			return &run, nil
+		case jobs.RunLifeCycleStateTerminated, jobs.RunLifeCycleStateSkipped, jobs.RunLifeCycleStateInternalError:
+			failed := run.State.ResultState != jobs.RunResultStateSuccess
+			if failed && run.StartTime >= since.UnixMilli() {
+				return nil, retries.Halt(fmt.Errorf("run %d of job %s failed: %s", run.RunId, id, run.State.StateMessage))
+			}
+			return nil, retries.Continues("waiting for the job to start a new run")
+		}
+		return nil, retries.Continues(fmt.Sprintf("run %d is %s", run.RunId, run.State.LifeCycleState))
+	})
+	return err
+}
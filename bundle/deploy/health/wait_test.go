@@ -0,0 +1,180 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/internal/bundletest"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/qa"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBundle returns a bundle with waiting for healthy resources enabled.
+func testBundle(t *testing.T, fixtures qa.HTTPFixtures, r config.Resources) *bundle.Bundle {
+	b := bundletest.WithFixtures(t, fixtures, r)
+	b.Config.Bundle.Deployment.Wait = &config.DeploymentWait{Enabled: true}
+	return b
+}
+
+func testContext() context.Context {
+	return cmdio.NewContext(context.Background(), cmdio.NewLogger(flags.ModeAppend))
+}
+
+func TestCollectChecks(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"continuous": {ID: "1", JobSettings: &jobs.JobSettings{Continuous: &jobs.Continuous{}}},
+					"paused":     {ID: "2", JobSettings: &jobs.JobSettings{Continuous: &jobs.Continuous{PauseStatus: jobs.PauseStatusPaused}}},
+					"triggered":  {ID: "3", JobSettings: &jobs.JobSettings{}},
+				},
+				Pipelines: map[string]*resources.Pipeline{
+					"continuous":   {ID: "a", PipelineSpec: &pipelines.PipelineSpec{Continuous: true}},
+					"triggered":    {ID: "b", PipelineSpec: &pipelines.PipelineSpec{}},
+					"not_deployed": {PipelineSpec: &pipelines.PipelineSpec{Continuous: true}},
+				},
+				ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+					"endpoint": {ID: "my-endpoint"},
+				},
+			},
+		},
+	}
+
+	var keys []string
+	for _, c := range collectChecks(b, time.Now()) {
+		keys = append(keys, c.key)
+	}
+	assert.Equal(t, []string{"model_serving_endpoints.endpoint", "pipelines.continuous", "jobs.continuous"}, keys)
+}
+
+func TestWaitDisabled(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+					"endpoint": {ID: "my-endpoint"},
+				},
+			},
+		},
+	}
+
+	// No requests are made if waiting is not enabled.
+	err := bundle.Apply(testContext(), b, Wait())
+	require.NoError(t, err)
+}
+
+func TestWaitEndpointUpdateFailed(t *testing.T) {
+	b := testBundle(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/serving-endpoints/my-endpoint?",
+			Response: serving.ServingEndpointDetailed{
+				Name: "my-endpoint",
+				State: &serving.EndpointState{
+					Ready:        serving.EndpointStateReadyReady,
+					ConfigUpdate: serving.EndpointStateConfigUpdateUpdateFailed,
+				},
+				PendingConfig: &serving.EndpointPendingConfig{
+					ServedModels: []serving.ServedModelOutput{
+						{
+							Name: "model-2",
+							State: &serving.ServedModelState{
+								Deployment:             serving.ServedModelStateDeploymentDeploymentFailed,
+								DeploymentStateMessage: "Container build failed",
+							},
+						},
+					},
+				},
+			},
+		},
+	}, config.Resources{
+		ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+			"endpoint": {ID: "my-endpoint"},
+		},
+	})
+
+	err := bundle.Apply(testContext(), b, Wait())
+	assert.EqualError(t, err, "model_serving_endpoints.endpoint is not healthy: failed to update the configuration of endpoint my-endpoint: model-2: Container build failed")
+}
+
+func TestWaitPipelineFailed(t *testing.T) {
+	b := testBundle(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/my-pipeline?",
+			Response: pipelines.GetPipelineResponse{
+				PipelineId: "my-pipeline",
+				State:      pipelines.PipelineStateFailed,
+				Cause:      "Cluster failed to launch",
+			},
+		},
+	}, config.Resources{
+		Pipelines: map[string]*resources.Pipeline{
+			"pipeline": {ID: "my-pipeline", PipelineSpec: &pipelines.PipelineSpec{Continuous: true}},
+		},
+	})
+
+	err := bundle.Apply(testContext(), b, Wait())
+	assert.EqualError(t, err, "pipelines.pipeline is not healthy: pipeline my-pipeline is FAILED: Cluster failed to launch")
+}
+
+func TestWaitJobRunning(t *testing.T) {
+	b := testBundle(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/runs/list?job_id=123&limit=1",
+			Response: jobs.ListRunsResponse{
+				Runs: []jobs.BaseRun{
+					{RunId: 1, State: &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateRunning}},
+				},
+			},
+		},
+	}, config.Resources{
+		Jobs: map[string]*resources.Job{
+			"job": {ID: "123", JobSettings: &jobs.JobSettings{Continuous: &jobs.Continuous{}}},
+		},
+	})
+
+	err := bundle.Apply(testContext(), b, Wait())
+	require.NoError(t, err)
+}
+
+func TestWaitJobRunFailed(t *testing.T) {
+	b := testBundle(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/runs/list?job_id=123&limit=1",
+			Response: jobs.ListRunsResponse{
+				Runs: []jobs.BaseRun{
+					{
+						RunId:     1,
+						StartTime: time.Now().Add(time.Minute).UnixMilli(),
+						State: &jobs.RunState{
+							LifeCycleState: jobs.RunLifeCycleStateInternalError,
+							ResultState:    jobs.RunResultStateFailed,
+							StateMessage:   "Task failed with an error",
+						},
+					},
+				},
+			},
+		},
+	}, config.Resources{
+		Jobs: map[string]*resources.Job{
+			"job": {ID: "123", JobSettings: &jobs.JobSettings{Continuous: &jobs.Continuous{}}},
+		},
+	})
+
+	err := bundle.Apply(testContext(), b, Wait())
+	assert.EqualError(t, err, "jobs.job is not healthy: run 1 of job 123 failed: Task failed with an error")
+}
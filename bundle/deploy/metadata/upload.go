@@ -7,6 +7,7 @@ import (
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/redact"
 )
 
 const MetadataFileName = "metadata.json"
@@ -32,5 +33,7 @@ func (m *upload) Apply(ctx context.Context, b *bundle.Bundle) error {
 		return err
 	}
 
+	// Never persist the values of sensitive variables.
+	metadata = redact.JSON(metadata)
 	return f.Write(ctx, MetadataFileName, bytes.NewReader(metadata), filer.CreateParentDirectories, filer.OverwriteIfExists)
 }
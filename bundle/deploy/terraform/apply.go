@@ -6,6 +6,8 @@ import (
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/terraform"
+	"github.com/fatih/color"
 	"github.com/hashicorp/terraform-exec/tfexec"
 )
 
@@ -15,20 +17,72 @@ func (w *apply) Name() string {
 	return "terraform.Apply"
 }
 
+// destructiveChanges returns the changes in the plan that delete or recreate a resource.
+func destructiveChanges(plan *terraform.Plan) []terraform.ResourceChange {
+	var out []terraform.ResourceChange
+	for _, c := range plan.Changes {
+		if c.Action.IsDestructive() {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// approve asks for confirmation if applying the plan deletes or recreates resources.
+func approve(ctx context.Context, b *bundle.Bundle) (bool, error) {
+	changes := destructiveChanges(b.Plan)
+	if len(changes) == 0 || b.Plan.ConfirmApply {
+		return true, nil
+	}
+
+	cmdio.LogString(ctx, "The following resources will be deleted or recreated:")
+	for _, c := range changes {
+		cmdio.LogString(ctx, fmt.Sprintf("  %-8s %s.%s", c.Action, c.Group, c.Key))
+	}
+
+	// Interactive consent is not possible; require the flag to be set explicitly.
+	if !cmdio.IsInteractive(ctx) {
+		return false, fmt.Errorf("the deployment deletes or recreates resources; please specify --auto-approve to skip interactive confirmation checks for non tty consoles")
+	}
+
+	red := color.New(color.FgRed).SprintFunc()
+	return cmdio.AskYesOrNo(ctx, fmt.Sprintf("\nThis will permanently %s the resources listed above! Proceed?", red("delete or recreate")))
+}
+
 func (w *apply) Apply(ctx context.Context, b *bundle.Bundle) error {
 	tf := b.Terraform
 	if tf == nil {
 		return fmt.Errorf("terraform not initialized")
 	}
 
+	if b.Plan == nil || b.Plan.Path == "" {
+		return fmt.Errorf("no plan found")
+	}
+
+	// return early if plan is empty
+	if b.Plan.IsEmpty {
+		cmdio.LogString(ctx, "No changes to deploy in plan. Skipping resource deployment!")
+		return nil
+	}
+
+	approved, err := approve(ctx, b)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		return fmt.Errorf("deployment cancelled")
+	}
+	b.Plan.ConfirmApply = true
+
 	cmdio.LogString(ctx, "Starting resource deployment")
 
-	err := tf.Init(ctx, tfexec.Upgrade(true))
+	err = tf.Init(ctx, tfexec.Upgrade(true))
 	if err != nil {
 		return fmt.Errorf("terraform init: %w", err)
 	}
 
-	err = tf.Apply(ctx)
+	// Apply terraform according to the computed plan
+	err = tf.Apply(ctx, tfexec.DirOrPlan(b.Plan.Path))
 	if err != nil {
 		return fmt.Errorf("terraform apply: %w", err)
 	}
@@ -37,8 +91,9 @@ func (w *apply) Apply(ctx context.Context, b *bundle.Bundle) error {
 	return nil
 }
 
-// Apply returns a [bundle.Mutator] that runs the equivalent of `terraform apply`
+// Apply returns a [bundle.Mutator] that runs the equivalent of `terraform apply ./plan`
 // from the bundle's ephemeral working directory for Terraform.
+// It asks for confirmation if the plan deletes or recreates resources.
 func Apply() bundle.Mutator {
 	return &apply{}
 }
@@ -0,0 +1,63 @@
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/cli/libs/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func nonInteractiveContext(t *testing.T) context.Context {
+	t.Setenv("NO_COLOR", "1")
+	var buf bytes.Buffer
+	return cmdio.InContext(context.Background(), cmdio.NewIO(flags.OutputText, nil, &buf, &buf, ""))
+}
+
+func TestApproveWithoutDestructiveChanges(t *testing.T) {
+	b := &bundle.Bundle{
+		Plan: &terraform.Plan{
+			Changes: []terraform.ResourceChange{
+				{Group: "jobs", Key: "foo", Action: terraform.ActionCreate},
+				{Group: "jobs", Key: "bar", Action: terraform.ActionUpdate},
+			},
+		},
+	}
+
+	approved, err := approve(nonInteractiveContext(t), b)
+	require.NoError(t, err)
+	assert.True(t, approved)
+}
+
+func TestApproveDestructiveChangesWithAutoApprove(t *testing.T) {
+	b := &bundle.Bundle{
+		Plan: &terraform.Plan{
+			ConfirmApply: true,
+			Changes: []terraform.ResourceChange{
+				{Group: "pipelines", Key: "foo", Action: terraform.ActionRecreate},
+			},
+		},
+	}
+
+	approved, err := approve(nonInteractiveContext(t), b)
+	require.NoError(t, err)
+	assert.True(t, approved)
+}
+
+func TestApproveDestructiveChangesNonInteractive(t *testing.T) {
+	b := &bundle.Bundle{
+		Plan: &terraform.Plan{
+			Changes: []terraform.ResourceChange{
+				{Group: "model_serving_endpoints", Key: "foo", Action: terraform.ActionDelete},
+			},
+		},
+	}
+
+	_, err := approve(nonInteractiveContext(t), b)
+	assert.ErrorContains(t, err, "please specify --auto-approve")
+}
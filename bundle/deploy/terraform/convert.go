@@ -203,6 +203,91 @@ func BundleToTerraform(config *config.Root) *schema.Root {
 		}
 	}
 
+	for k, src := range config.Resources.Schemas {
+		noResources = false
+		var dst schema.ResourceSchema
+		conv(src, &dst)
+		tfroot.Resource.Schema[k] = &dst
+
+		// Configure grants for this resource.
+		if rp := convGrants(src.Grants); rp != nil {
+			rp.Schema = fmt.Sprintf("${databricks_schema.%s.id}", k)
+			tfroot.Resource.Grants["schema_"+k] = rp
+		}
+	}
+
+	for k, src := range config.Resources.Volumes {
+		noResources = false
+		var dst schema.ResourceVolume
+		conv(src, &dst)
+		tfroot.Resource.Volume[k] = &dst
+
+		// Configure grants for this resource.
+		if rp := convGrants(src.Grants); rp != nil {
+			rp.Volume = fmt.Sprintf("${databricks_volume.%s.id}", k)
+			tfroot.Resource.Grants["volume_"+k] = rp
+		}
+	}
+
+	for k, src := range config.Resources.SqlWarehouses {
+		noResources = false
+		var dst schema.ResourceSqlEndpoint
+		conv(src, &dst)
+		tfroot.Resource.SqlEndpoint[k] = &dst
+
+		// Configure permissions for this resource.
+		if rp := convPermissions(src.Permissions); rp != nil {
+			rp.SqlEndpointId = fmt.Sprintf("${databricks_sql_endpoint.%s.id}", k)
+			tfroot.Resource.Permissions["sql_endpoint_"+k] = rp
+		}
+	}
+
+	for k, src := range config.Resources.Queries {
+		noResources = false
+		var dst schema.ResourceSqlQuery
+		conv(src, &dst)
+		tfroot.Resource.SqlQuery[k] = &dst
+
+		// Configure permissions for this resource.
+		if rp := convPermissions(src.Permissions); rp != nil {
+			rp.SqlQueryId = fmt.Sprintf("${databricks_sql_query.%s.id}", k)
+			tfroot.Resource.Permissions["sql_query_"+k] = rp
+		}
+	}
+
+	for k, src := range config.Resources.Alerts {
+		noResources = false
+		var dst schema.ResourceSqlAlert
+		conv(src, &dst)
+
+		// The value to compare against can be a string, number or boolean,
+		// but Terraform expects a string.
+		if src.CreateAlert != nil && dst.Options != nil && src.Options.Value != nil {
+			dst.Options.Value = fmt.Sprint(src.Options.Value)
+		}
+
+		tfroot.Resource.SqlAlert[k] = &dst
+
+		// Configure permissions for this resource.
+		if rp := convPermissions(src.Permissions); rp != nil {
+			rp.SqlAlertId = fmt.Sprintf("${databricks_sql_alert.%s.id}", k)
+			tfroot.Resource.Permissions["sql_alert_"+k] = rp
+		}
+	}
+
+	for k, src := range config.Resources.Dashboards {
+		noResources = false
+		var dst schema.ResourceSqlDashboard
+		conv(src, &dst)
+		tfroot.Resource.SqlDashboard[k] = &dst
+
+		// Configure permissions for this resource.
+		if rp := convPermissions(src.Permissions); rp != nil {
+			rp.SqlDashboardId = fmt.Sprintf("${databricks_sql_dashboard.%s.id}", k)
+			tfroot.Resource.Permissions["sql_dashboard_"+k] = rp
+		}
+	}
+
 	// We explicitly set "resource" to nil to omit it from a JSON encoding.
 	// This is required because the terraform CLI requires >= 1 resources defined
 	// if the "resource" property is used in a .tf.json file.
@@ -261,6 +346,42 @@ func TerraformToBundle(state *tfjson.State, config *config.Root) error {
 			cur := config.Resources.RegisteredModels[resource.Name]
 			conv(tmp, &cur)
 			config.Resources.RegisteredModels[resource.Name] = cur
+		case "databricks_schema":
+			var tmp schema.ResourceSchema
+			conv(resource.AttributeValues, &tmp)
+			cur := config.Resources.Schemas[resource.Name]
+			conv(tmp, &cur)
+			config.Resources.Schemas[resource.Name] = cur
+		case "databricks_volume":
+			var tmp schema.ResourceVolume
+			conv(resource.AttributeValues, &tmp)
+			cur := config.Resources.Volumes[resource.Name]
+			conv(tmp, &cur)
+			config.Resources.Volumes[resource.Name] = cur
+		case "databricks_sql_endpoint":
+			var tmp schema.ResourceSqlEndpoint
+			conv(resource.AttributeValues, &tmp)
+			cur := config.Resources.SqlWarehouses[resource.Name]
+			conv(tmp, &cur)
+			config.Resources.SqlWarehouses[resource.Name] = cur
+		case "databricks_sql_query":
+			var tmp schema.ResourceSqlQuery
+			conv(resource.AttributeValues, &tmp)
+			cur := config.Resources.Queries[resource.Name]
+			conv(tmp, &cur)
+			config.Resources.Queries[resource.Name] = cur
+		case "databricks_sql_alert":
+			var tmp schema.ResourceSqlAlert
+			conv(resource.AttributeValues, &tmp)
+			cur := config.Resources.Alerts[resource.Name]
+			conv(tmp, &cur)
+			config.Resources.Alerts[resource.Name] = cur
+		case "databricks_sql_dashboard":
+			var tmp schema.ResourceSqlDashboard
+			conv(resource.AttributeValues, &tmp)
+			cur := config.Resources.Dashboards[resource.Name]
+			conv(tmp, &cur)
+			config.Resources.Dashboards[resource.Name] = cur
 		case "databricks_permissions":
 		case "databricks_grants":
 			// Ignore; no need to pull these back into the configuration.
@@ -11,6 +11,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/service/ml"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
 	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -422,3 +423,247 @@ func TestConvertRegisteredModelGrants(t *testing.T) {
 	assert.Equal(t, "EXECUTE", p.Privileges[0])
 
 }
+
+func TestConvertSchema(t *testing.T) {
+	var src = resources.Schema{
+		CreateSchema: &catalog.CreateSchema{
+			Name:        "name",
+			CatalogName: "catalog",
+			Comment:     "comment",
+			Properties: map[string]string{
+				"k": "v",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Schemas: map[string]*resources.Schema{
+				"my_schema": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	resource := out.Resource.Schema["my_schema"]
+	assert.Equal(t, "name", resource.Name)
+	assert.Equal(t, "catalog", resource.CatalogName)
+	assert.Equal(t, "comment", resource.Comment)
+	assert.Equal(t, map[string]string{"k": "v"}, resource.Properties)
+	assert.Nil(t, out.Data)
+}
+
+func TestConvertSchemaGrants(t *testing.T) {
+	var src = resources.Schema{
+		Grants: []resources.Grant{
+			{
+				Privileges: []string{"USE_SCHEMA", "CREATE_TABLE"},
+				Principal:  "jane@doe.com",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Schemas: map[string]*resources.Schema{
+				"my_schema": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	assert.Equal(t, "${databricks_schema.my_schema.id}", out.Resource.Grants["schema_my_schema"].Schema)
+	assert.Len(t, out.Resource.Grants["schema_my_schema"].Grant, 1)
+
+	p := out.Resource.Grants["schema_my_schema"].Grant[0]
+	assert.Equal(t, "jane@doe.com", p.Principal)
+	assert.Equal(t, []string{"USE_SCHEMA", "CREATE_TABLE"}, p.Privileges)
+}
+
+func TestConvertVolume(t *testing.T) {
+	var src = resources.Volume{
+		CreateVolumeRequestContent: &catalog.CreateVolumeRequestContent{
+			Name:        "name",
+			CatalogName: "catalog",
+			SchemaName:  "schema",
+			VolumeType:  catalog.VolumeTypeManaged,
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Volumes: map[string]*resources.Volume{
+				"my_volume": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	resource := out.Resource.Volume["my_volume"]
+	assert.Equal(t, "name", resource.Name)
+	assert.Equal(t, "catalog", resource.CatalogName)
+	assert.Equal(t, "schema", resource.SchemaName)
+	assert.Equal(t, "MANAGED", resource.VolumeType)
+	assert.Nil(t, out.Data)
+}
+
+func TestConvertVolumeGrants(t *testing.T) {
+	var src = resources.Volume{
+		Grants: []resources.Grant{
+			{
+				Privileges: []string{"READ_VOLUME"},
+				Principal:  "jane@doe.com",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Volumes: map[string]*resources.Volume{
+				"my_volume": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	assert.Equal(t, "${databricks_volume.my_volume.id}", out.Resource.Grants["volume_my_volume"].Volume)
+	assert.Len(t, out.Resource.Grants["volume_my_volume"].Grant, 1)
+
+	p := out.Resource.Grants["volume_my_volume"].Grant[0]
+	assert.Equal(t, "jane@doe.com", p.Principal)
+	assert.Equal(t, []string{"READ_VOLUME"}, p.Privileges)
+}
+
+func TestConvertSqlWarehouse(t *testing.T) {
+	var src = resources.SqlWarehouse{
+		CreateWarehouseRequest: &sql.CreateWarehouseRequest{
+			Name:           "name",
+			ClusterSize:    "2X-Small",
+			MaxNumClusters: 1,
+			AutoStopMins:   10,
+			Tags: &sql.EndpointTags{
+				CustomTags: []sql.EndpointTagPair{{Key: "team", Value: "analytics"}},
+			},
+		},
+		Permissions: []resources.Permission{
+			{
+				Level:     "CAN_USE",
+				GroupName: "analysts",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			SqlWarehouses: map[string]*resources.SqlWarehouse{
+				"my_warehouse": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	resource := out.Resource.SqlEndpoint["my_warehouse"]
+	assert.Equal(t, "name", resource.Name)
+	assert.Equal(t, "2X-Small", resource.ClusterSize)
+	assert.Equal(t, 1, resource.MaxNumClusters)
+	assert.Equal(t, 10, resource.AutoStopMins)
+	assert.Equal(t, "analytics", resource.Tags.CustomTags[0].Value)
+
+	assert.Equal(t, "${databricks_sql_endpoint.my_warehouse.id}", out.Resource.Permissions["sql_endpoint_my_warehouse"].SqlEndpointId)
+	assert.Equal(t, "analysts", out.Resource.Permissions["sql_endpoint_my_warehouse"].AccessControl[0].GroupName)
+}
+
+func TestConvertQuery(t *testing.T) {
+	var src = resources.Query{
+		File: "/bundle/queries/revenue.sql",
+		QueryPostContent: &sql.QueryPostContent{
+			Name:         "revenue",
+			DataSourceId: "1234",
+			Query:        "SELECT 1",
+			Parent:       "folders/1",
+		},
+		Permissions: []resources.Permission{
+			{
+				Level:     "CAN_RUN",
+				GroupName: "analysts",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Queries: map[string]*resources.Query{
+				"my_query": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	resource := out.Resource.SqlQuery["my_query"]
+	assert.Equal(t, "revenue", resource.Name)
+	assert.Equal(t, "1234", resource.DataSourceId)
+	assert.Equal(t, "SELECT 1", resource.Query)
+	assert.Equal(t, "folders/1", resource.Parent)
+	assert.Equal(t, "${databricks_sql_query.my_query.id}", out.Resource.Permissions["sql_query_my_query"].SqlQueryId)
+}
+
+func TestConvertAlert(t *testing.T) {
+	var src = resources.Alert{
+		CreateAlert: &sql.CreateAlert{
+			Name:    "revenue dropped",
+			QueryId: "${databricks_sql_query.my_query.id}",
+			Rearm:   60,
+			Options: sql.AlertOptions{
+				Column: "total",
+				Op:     "<",
+				Value:  int64(1000),
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Alerts: map[string]*resources.Alert{
+				"my_alert": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	resource := out.Resource.SqlAlert["my_alert"]
+	assert.Equal(t, "revenue dropped", resource.Name)
+	assert.Equal(t, "${databricks_sql_query.my_query.id}", resource.QueryId)
+	assert.Equal(t, 60, resource.Rearm)
+	assert.Equal(t, "total", resource.Options.Column)
+	assert.Equal(t, "<", resource.Options.Op)
+	assert.Equal(t, "1000", resource.Options.Value)
+}
+
+func TestConvertDashboard(t *testing.T) {
+	var src = resources.Dashboard{
+		CreateDashboardRequest: &sql.CreateDashboardRequest{
+			Name: "revenue",
+			Tags: []string{"finance"},
+		},
+		Permissions: []resources.Permission{
+			{
+				Level:    "CAN_VIEW",
+				UserName: "jane@doe.com",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Dashboards: map[string]*resources.Dashboard{
+				"my_dashboard": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	resource := out.Resource.SqlDashboard["my_dashboard"]
+	assert.Equal(t, "revenue", resource.Name)
+	assert.Equal(t, []string{"finance"}, resource.Tags)
+	assert.Equal(t, "${databricks_sql_dashboard.my_dashboard.id}", out.Resource.Permissions["sql_dashboard_my_dashboard"].SqlDashboardId)
+}
@@ -0,0 +1,125 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/hashicorp/terraform-exec/tfexec"
+)
+
+type BindOptions struct {
+	AutoApprove  bool
+	ResourceType string
+	ResourceKey  string
+	ResourceId   string
+}
+
+func (o *BindOptions) address() string {
+	return fmt.Sprintf("%s.%s", o.ResourceType, o.ResourceKey)
+}
+
+type importResource struct {
+	opts *BindOptions
+}
+
+func (m *importResource) Name() string {
+	return "terraform.Import"
+}
+
+func (m *importResource) Apply(ctx context.Context, b *bundle.Bundle) error {
+	tf := b.Terraform
+	if tf == nil {
+		return fmt.Errorf("terraform not initialized")
+	}
+
+	dir, err := Dir(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	err = tf.Init(ctx, tfexec.Upgrade(true))
+	if err != nil {
+		return fmt.Errorf("terraform init: %w", err)
+	}
+
+	// Import into a copy of the state, so the local state is left untouched
+	// if the import fails or the changes it implies are not confirmed.
+	tmpDir, err := os.MkdirTemp("", "state-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	tmpState := filepath.Join(tmpDir, TerraformStateFileName)
+	address := m.opts.address()
+	err = tf.Import(ctx, address, m.opts.ResourceId, tfexec.StateOut(tmpState))
+	if err != nil {
+		return fmt.Errorf("terraform import: %w", err)
+	}
+
+	// Compute the changes that the next deployment applies to the imported resource.
+	planPath := filepath.Join(tmpDir, "plan")
+	changed, err := tf.Plan(ctx, tfexec.State(tmpState), tfexec.Target(address), tfexec.Out(planPath))
+	if err != nil {
+		return fmt.Errorf("terraform plan: %w", err)
+	}
+
+	if changed && !m.opts.AutoApprove {
+		plan, err := tf.ShowPlanFile(ctx, planPath)
+		if err != nil {
+			return fmt.Errorf("terraform show: %w", err)
+		}
+
+		cmdio.LogString(ctx, "The configuration of the bundle differs from the existing resource:")
+		for _, c := range PlanChanges(plan) {
+			cmdio.LogString(ctx, fmt.Sprintf("  %-8s %s.%s", c.Action, c.Group, c.Key))
+			for _, f := range c.Fields {
+				cmdio.LogString(ctx, fmt.Sprintf("           ~ %s", f))
+			}
+		}
+
+		// Interactive consent is not possible; require the flag to be set explicitly.
+		if !cmdio.IsInteractive(ctx) {
+			return fmt.Errorf("binding the resource implies changes; please specify --auto-approve to skip interactive confirmation checks for non tty consoles")
+		}
+
+		ok, err := cmdio.AskYesOrNo(ctx, "Confirm import changes? Changes will be remotely applied only after running 'bundle deploy'.")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("import aborted")
+		}
+	}
+
+	// Replace the local state with the state that includes the imported resource.
+	return copyFile(tmpState, filepath.Join(dir, TerraformStateFileName))
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// Import returns a [bundle.Mutator] that runs the equivalent of `terraform import`
+// to bind a resource in the bundle configuration to an existing object in the workspace.
+func Import(opts *BindOptions) bundle.Mutator {
+	return &importResource{opts: opts}
+}
@@ -31,6 +31,24 @@ func interpolateTerraformResourceIdentifiers(path string, lookup map[string]stri
 		case "registered_models":
 			path = strings.Join(append([]string{"databricks_registered_model"}, parts[2:]...), interpolation.Delimiter)
 			return fmt.Sprintf("${%s}", path), nil
+		case "schemas":
+			path = strings.Join(append([]string{"databricks_schema"}, parts[2:]...), interpolation.Delimiter)
+			return fmt.Sprintf("${%s}", path), nil
+		case "volumes":
+			path = strings.Join(append([]string{"databricks_volume"}, parts[2:]...), interpolation.Delimiter)
+			return fmt.Sprintf("${%s}", path), nil
+		case "sql_warehouses":
+			path = strings.Join(append([]string{"databricks_sql_endpoint"}, parts[2:]...), interpolation.Delimiter)
+			return fmt.Sprintf("${%s}", path), nil
+		case "queries":
+			path = strings.Join(append([]string{"databricks_sql_query"}, parts[2:]...), interpolation.Delimiter)
+			return fmt.Sprintf("${%s}", path), nil
+		case "alerts":
+			path = strings.Join(append([]string{"databricks_sql_alert"}, parts[2:]...), interpolation.Delimiter)
+			return fmt.Sprintf("${%s}", path), nil
+		case "dashboards":
+			path = strings.Join(append([]string{"databricks_sql_dashboard"}, parts[2:]...), interpolation.Delimiter)
+			return fmt.Sprintf("${%s}", path), nil
 		default:
 			panic("TODO: " + parts[1])
 		}
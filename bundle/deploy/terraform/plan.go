@@ -59,6 +59,15 @@ func (p *plan) Apply(ctx context.Context, b *bundle.Bundle) error {
 		IsEmpty:      !notEmpty,
 	}
 
+	// Summarize the changes in the plan so they can be inspected before applying it.
+	if notEmpty {
+		jsonPlan, err := tf.ShowPlanFile(ctx, planPath)
+		if err != nil {
+			return fmt.Errorf("terraform show: %w", err)
+		}
+		b.Plan.Changes = PlanChanges(jsonPlan)
+	}
+
 	cmdio.LogString(ctx, fmt.Sprintf("Planning complete and persisted at %s\n", planPath))
 	return nil
 }
@@ -0,0 +1,155 @@
+package terraform
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/databricks/cli/libs/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// Maps Terraform resource types to the resource group they are configured
+// under in the bundle configuration.
+var resourceGroups = map[string]string{
+	"databricks_job":               "jobs",
+	"databricks_pipeline":          "pipelines",
+	"databricks_mlflow_model":      "models",
+	"databricks_mlflow_experiment": "experiments",
+	"databricks_model_serving":     "model_serving_endpoints",
+	"databricks_registered_model":  "registered_models",
+	"databricks_schema":            "schemas",
+	"databricks_volume":            "volumes",
+	"databricks_sql_endpoint":      "sql_warehouses",
+	"databricks_sql_query":         "queries",
+	"databricks_sql_alert":         "alerts",
+	"databricks_sql_dashboard":     "dashboards",
+	"databricks_permissions":       "permissions",
+	"databricks_grants":            "grants",
+}
+
+func resourceGroup(tfType string) string {
+	group, ok := resourceGroups[tfType]
+	if !ok {
+		return tfType
+	}
+	return group
+}
+
+func planAction(actions tfjson.Actions) (terraform.Action, bool) {
+	switch {
+	case actions.Replace():
+		return terraform.ActionRecreate, true
+	case actions.Create():
+		return terraform.ActionCreate, true
+	case actions.Update():
+		return terraform.ActionUpdate, true
+	case actions.Delete():
+		return terraform.ActionDelete, true
+	default:
+		return "", false
+	}
+}
+
+// PlanChanges summarizes the resource changes in a Terraform plan.
+// Resources that are not changed by the plan are omitted.
+func PlanChanges(plan *tfjson.Plan) []terraform.ResourceChange {
+	var changes []terraform.ResourceChange
+	for _, rc := range plan.ResourceChanges {
+		if rc.Mode != tfjson.ManagedResourceMode || rc.Change == nil {
+			continue
+		}
+
+		action, ok := planAction(rc.Change.Actions)
+		if !ok {
+			continue
+		}
+
+		change := terraform.ResourceChange{
+			Group:  resourceGroup(rc.Type),
+			Key:    rc.Name,
+			Action: action,
+		}
+
+		if action == terraform.ActionUpdate || action == terraform.ActionRecreate {
+			change.Fields = changedFields("", rc.Change.Before, rc.Change.After, rc.Change.AfterUnknown)
+		}
+
+		changes = append(changes, change)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Group != changes[j].Group {
+			return changes[i].Group < changes[j].Group
+		}
+		return changes[i].Key < changes[j].Key
+	})
+
+	return changes
+}
+
+func joinPath(prefix string, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// changedFields returns the paths of the leaf values that differ between before and after.
+// Values that are only known after apply (e.g. computed IDs) are not considered changed.
+func changedFields(prefix string, before, after, afterUnknown any) []string {
+	if unknown, ok := afterUnknown.(bool); ok && unknown {
+		return nil
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]any)
+	afterMap, afterIsMap := after.(map[string]any)
+	if (beforeIsMap || before == nil) && (afterIsMap || after == nil) && (beforeIsMap || afterIsMap) {
+		unknownMap, _ := afterUnknown.(map[string]any)
+		keys := make(map[string]struct{})
+		for k := range beforeMap {
+			keys[k] = struct{}{}
+		}
+		for k := range afterMap {
+			keys[k] = struct{}{}
+		}
+
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+
+		var out []string
+		for _, k := range sorted {
+			out = append(out, changedFields(joinPath(prefix, k), beforeMap[k], afterMap[k], unknownMap[k])...)
+		}
+		return out
+	}
+
+	beforeSlice, beforeIsSlice := before.([]any)
+	afterSlice, afterIsSlice := after.([]any)
+	if (beforeIsSlice || before == nil) && (afterIsSlice || after == nil) && (beforeIsSlice || afterIsSlice) {
+		unknownSlice, _ := afterUnknown.([]any)
+		var out []string
+		for i := 0; i < len(beforeSlice) || i < len(afterSlice); i++ {
+			var b, a, u any
+			if i < len(beforeSlice) {
+				b = beforeSlice[i]
+			}
+			if i < len(afterSlice) {
+				a = afterSlice[i]
+			}
+			if i < len(unknownSlice) {
+				u = unknownSlice[i]
+			}
+			out = append(out, changedFields(fmt.Sprintf("%s[%d]", prefix, i), b, a, u)...)
+		}
+		return out
+	}
+
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+	return []string{prefix}
+}
@@ -0,0 +1,100 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/libs/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanChanges(t *testing.T) {
+	plan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Mode: tfjson.ManagedResourceMode,
+				Type: "databricks_pipeline",
+				Name: "my_pipeline",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+					Before:  map[string]any{"catalog": "main", "name": "foo"},
+					After:   map[string]any{"catalog": "other", "name": "foo"},
+				},
+			},
+			{
+				Mode: tfjson.ManagedResourceMode,
+				Type: "databricks_job",
+				Name: "my_job",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionUpdate},
+					Before: map[string]any{
+						"id":   "123",
+						"name": "foo",
+						"task": []any{
+							map[string]any{"task_key": "a"},
+						},
+					},
+					After: map[string]any{
+						"name": "bar",
+						"task": []any{
+							map[string]any{"task_key": "a"},
+							map[string]any{"task_key": "b"},
+						},
+					},
+					AfterUnknown: map[string]any{"id": true},
+				},
+			},
+			{
+				Mode: tfjson.ManagedResourceMode,
+				Type: "databricks_job",
+				Name: "new_job",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionCreate},
+					After:   map[string]any{"name": "new"},
+				},
+			},
+			{
+				Mode: tfjson.ManagedResourceMode,
+				Type: "databricks_mlflow_model",
+				Name: "unchanged",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionNoop},
+				},
+			},
+			{
+				Mode: tfjson.ManagedResourceMode,
+				Type: "databricks_model_serving",
+				Name: "my_endpoint",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionDelete},
+					Before:  map[string]any{"name": "endpoint"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []terraform.ResourceChange{
+		{
+			Group:  "jobs",
+			Key:    "my_job",
+			Action: terraform.ActionUpdate,
+			Fields: []string{"name", "task[1].task_key"},
+		},
+		{
+			Group:  "jobs",
+			Key:    "new_job",
+			Action: terraform.ActionCreate,
+		},
+		{
+			Group:  "model_serving_endpoints",
+			Key:    "my_endpoint",
+			Action: terraform.ActionDelete,
+		},
+		{
+			Group:  "pipelines",
+			Key:    "my_pipeline",
+			Action: terraform.ActionRecreate,
+			Fields: []string{"catalog"},
+		},
+	}, PlanChanges(plan))
+}
@@ -0,0 +1,29 @@
+package bundletest
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/databricks-sdk-go/qa"
+)
+
+// WithFixtures returns a bundle with the specified resources and a workspace client
+// that is served by the fixtures.
+func WithFixtures(t *testing.T, fixtures qa.HTTPFixtures, r config.Resources) *bundle.Bundle {
+	cfg, server := fixtures.Config(t)
+	t.Cleanup(server.Close)
+
+	// The bundle constructs its client from the workspace configuration.
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("DATABRICKS_TOKEN", cfg.Token)
+
+	return &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				Host: cfg.Host,
+			},
+			Resources: r,
+		},
+	}
+}
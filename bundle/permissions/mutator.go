@@ -38,6 +38,15 @@ var levelsMap = map[string](map[string]string){
 		CAN_VIEW:   "CAN_VIEW",
 		CAN_RUN:    "CAN_QUERY",
 	},
+	"sql_warehouses": {
+		CAN_MANAGE: "CAN_MANAGE",
+		CAN_RUN:    "CAN_USE",
+	},
+	"sql_objects": {
+		CAN_MANAGE: "CAN_MANAGE",
+		CAN_VIEW:   "CAN_VIEW",
+		CAN_RUN:    "CAN_RUN",
+	},
 }
 
 type bundlePermissions struct{}
@@ -57,6 +66,8 @@ func (m *bundlePermissions) Apply(ctx context.Context, b *bundle.Bundle) error {
 	applyForMlModels(ctx, b)
 	applyForMlExperiments(ctx, b)
 	applyForModelServiceEndpoints(ctx, b)
+	applyForSqlWarehouses(ctx, b)
+	applyForSqlObjects(ctx, b)
 
 	return nil
 }
@@ -131,6 +142,49 @@ func applyForModelServiceEndpoints(ctx context.Context, b *bundle.Bundle) {
 	}
 }
 
+func applyForSqlWarehouses(ctx context.Context, b *bundle.Bundle) {
+	for _, warehouse := range b.Config.Resources.SqlWarehouses {
+		warehouse.Permissions = append(warehouse.Permissions, convert(
+			ctx,
+			b.Config.Permissions,
+			warehouse.Permissions,
+			warehouse.Name,
+			levelsMap["sql_warehouses"],
+		)...)
+	}
+}
+
+// Queries, alerts and dashboards share the same permission levels.
+func applyForSqlObjects(ctx context.Context, b *bundle.Bundle) {
+	for _, query := range b.Config.Resources.Queries {
+		query.Permissions = append(query.Permissions, convert(
+			ctx,
+			b.Config.Permissions,
+			query.Permissions,
+			query.Name,
+			levelsMap["sql_objects"],
+		)...)
+	}
+	for _, alert := range b.Config.Resources.Alerts {
+		alert.Permissions = append(alert.Permissions, convert(
+			ctx,
+			b.Config.Permissions,
+			alert.Permissions,
+			alert.Name,
+			levelsMap["sql_objects"],
+		)...)
+	}
+	for _, dashboard := range b.Config.Resources.Dashboards {
+		dashboard.Permissions = append(dashboard.Permissions, convert(
+			ctx,
+			b.Config.Permissions,
+			dashboard.Permissions,
+			dashboard.Name,
+			levelsMap["sql_objects"],
+		)...)
+	}
+}
+
 func (m *bundlePermissions) Name() string {
 	return "ApplyBundlePermissions"
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/service/ml"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
 	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/stretchr/testify/require"
 )
 
@@ -46,6 +47,18 @@ func TestApplyBundlePermissions(t *testing.T) {
 					"endpoint_1": {CreateServingEndpoint: &serving.CreateServingEndpoint{}},
 					"endpoint_2": {CreateServingEndpoint: &serving.CreateServingEndpoint{}},
 				},
+				SqlWarehouses: map[string]*resources.SqlWarehouse{
+					"warehouse_1": {CreateWarehouseRequest: &sql.CreateWarehouseRequest{}},
+				},
+				Queries: map[string]*resources.Query{
+					"query_1": {QueryPostContent: &sql.QueryPostContent{}},
+				},
+				Alerts: map[string]*resources.Alert{
+					"alert_1": {CreateAlert: &sql.CreateAlert{}},
+				},
+				Dashboards: map[string]*resources.Dashboard{
+					"dashboard_1": {CreateDashboardRequest: &sql.CreateDashboardRequest{}},
+				},
 			},
 		},
 	}
@@ -98,6 +111,21 @@ func TestApplyBundlePermissions(t *testing.T) {
 	require.Contains(t, b.Config.Resources.ModelServingEndpoints["endpoint_2"].Permissions, resources.Permission{Level: "CAN_MANAGE", UserName: "TestUser"})
 	require.Contains(t, b.Config.Resources.ModelServingEndpoints["endpoint_2"].Permissions, resources.Permission{Level: "CAN_VIEW", GroupName: "TestGroup"})
 	require.Contains(t, b.Config.Resources.ModelServingEndpoints["endpoint_2"].Permissions, resources.Permission{Level: "CAN_QUERY", ServicePrincipalName: "TestServicePrincipal"})
+
+	require.Len(t, b.Config.Resources.SqlWarehouses["warehouse_1"].Permissions, 2)
+	require.Contains(t, b.Config.Resources.SqlWarehouses["warehouse_1"].Permissions, resources.Permission{Level: "CAN_MANAGE", UserName: "TestUser"})
+	require.Contains(t, b.Config.Resources.SqlWarehouses["warehouse_1"].Permissions, resources.Permission{Level: "CAN_USE", ServicePrincipalName: "TestServicePrincipal"})
+
+	require.Len(t, b.Config.Resources.Queries["query_1"].Permissions, 3)
+	require.Contains(t, b.Config.Resources.Queries["query_1"].Permissions, resources.Permission{Level: "CAN_MANAGE", UserName: "TestUser"})
+	require.Contains(t, b.Config.Resources.Queries["query_1"].Permissions, resources.Permission{Level: "CAN_VIEW", GroupName: "TestGroup"})
+	require.Contains(t, b.Config.Resources.Queries["query_1"].Permissions, resources.Permission{Level: "CAN_RUN", ServicePrincipalName: "TestServicePrincipal"})
+
+	require.Len(t, b.Config.Resources.Alerts["alert_1"].Permissions, 3)
+	require.Contains(t, b.Config.Resources.Alerts["alert_1"].Permissions, resources.Permission{Level: "CAN_RUN", ServicePrincipalName: "TestServicePrincipal"})
+
+	require.Len(t, b.Config.Resources.Dashboards["dashboard_1"].Permissions, 3)
+	require.Contains(t, b.Config.Resources.Dashboards["dashboard_1"].Permissions, resources.Permission{Level: "CAN_VIEW", GroupName: "TestGroup"})
 }
 
 func TestWarningOnOverlapPermission(t *testing.T) {
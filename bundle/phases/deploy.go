@@ -6,6 +6,7 @@ import (
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/mutator"
 	"github.com/databricks/cli/bundle/deploy/files"
+	"github.com/databricks/cli/bundle/deploy/health"
 	"github.com/databricks/cli/bundle/deploy/lock"
 	"github.com/databricks/cli/bundle/deploy/metadata"
 	"github.com/databricks/cli/bundle/deploy/terraform"
@@ -32,6 +33,7 @@ func Deploy() bundle.Mutator {
 				terraform.Interpolate(),
 				terraform.Write(),
 				terraform.StatePull(),
+				terraform.Plan(terraform.PlanDeploy),
 				bundle.Defer(
 					terraform.Apply(),
 					bundle.Seq(
@@ -44,6 +46,7 @@ func Deploy() bundle.Mutator {
 			),
 			lock.Release(lock.GoalDeploy),
 		),
+		health.Wait(),
 		scripts.Execute(config.ScriptPostDeploy),
 	)
 
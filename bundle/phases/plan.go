@@ -0,0 +1,20 @@
+package phases
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+)
+
+// The plan phase computes the changes a deployment would make to the
+// deployed resources, without applying them.
+func Plan() bundle.Mutator {
+	return newPhase(
+		"plan",
+		[]bundle.Mutator{
+			terraform.Interpolate(),
+			terraform.Write(),
+			terraform.StatePull(),
+			terraform.Plan(terraform.PlanDeploy),
+		},
+	)
+}
@@ -0,0 +1,49 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/databricks/cli/libs/cmdio"
+)
+
+// withInterrupt returns a context that is canceled when the user interrupts
+// the command (e.g. presses Ctrl-C) while waiting for a remote run.
+// The returned function restores the default handling of interrupts.
+func withInterrupt(ctx context.Context) (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(ctx, os.Interrupt)
+}
+
+// isInterrupted returns true if the wait context was canceled
+// by an interrupt rather than by its parent context.
+func isInterrupted(ctx context.Context, waitCtx context.Context) bool {
+	return waitCtx.Err() != nil && ctx.Err() == nil
+}
+
+// handleInterrupt offers to cancel a remote run after the user interrupted waiting for it.
+// The run is not canceled if the session is not interactive.
+func handleInterrupt(ctx context.Context, what string, cancel func(ctx context.Context) error) error {
+	// Print the prompt on its own line, after the echoed interrupt character.
+	cmdio.LogString(ctx, "")
+
+	if !cmdio.IsInteractive(ctx) {
+		return fmt.Errorf("interrupted; the %s continues to run remotely", what)
+	}
+
+	ok, err := cmdio.AskYesOrNo(ctx, fmt.Sprintf("Interrupted. Cancel the %s?", what))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("interrupted; the %s continues to run remotely", what)
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Cancelling the %s", what))
+	err = cancel(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to cancel the %s: %w", what, err)
+	}
+	return fmt.Errorf("%s canceled", what)
+}
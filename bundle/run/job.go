@@ -2,8 +2,12 @@ package run
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/databricks/cli/bundle"
@@ -12,6 +16,8 @@ import (
 	"github.com/databricks/cli/bundle/run/progress"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/client"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/fatih/color"
 	flag "github.com/spf13/pflag"
@@ -27,6 +33,15 @@ type JobOptions struct {
 	pythonParams      []string
 	sparkSubmitParams []string
 	sqlParams         map[string]string
+
+	// Values for the parameters declared by the job.
+	jobParams map[string]string
+
+	// Keys of the tasks to run. If empty, all tasks are run.
+	only []string
+
+	// Repair the latest failed run instead of triggering a new run.
+	repair bool
 }
 
 func (o *JobOptions) Define(fs *flag.FlagSet) {
@@ -38,6 +53,33 @@ func (o *JobOptions) Define(fs *flag.FlagSet) {
 	fs.StringSliceVar(&o.pythonParams, "python-params", nil, "A list of parameters for jobs with Python tasks.")
 	fs.StringSliceVar(&o.sparkSubmitParams, "spark-submit-params", nil, "A list of parameters for jobs with Spark submit tasks.")
 	fs.StringToStringVar(&o.sqlParams, "sql-params", nil, "A map from keys to values for jobs with SQL tasks.")
+	fs.StringToStringVar(&o.jobParams, "params", nil, "A map from keys to values for the parameters declared by the job.")
+	fs.StringSliceVar(&o.only, "only", nil, "A list of task keys to run. If not specified, all tasks of the job are run.")
+	fs.BoolVar(&o.repair, "repair", false, "Repair the latest failed run of the job. Reruns all failed tasks, or the tasks specified with --only.")
+}
+
+// validateOnly checks that the tasks specified with --only are defined by the job.
+func (o *JobOptions) validateOnly(job *resources.Job) error {
+	if len(o.only) == 0 {
+		return nil
+	}
+
+	var keys []string
+	if job.JobSettings != nil {
+		for _, task := range job.Tasks {
+			keys = append(keys, task.TaskKey)
+		}
+	}
+
+	for _, key := range o.only {
+		if !slices.Contains(keys, key) {
+			if len(keys) == 0 {
+				return fmt.Errorf("task %q is not defined; the job does not define any tasks", key)
+			}
+			return fmt.Errorf("task %q is not defined; available tasks: %s", key, strings.Join(keys, ", "))
+		}
+	}
+	return nil
 }
 
 func (o *JobOptions) validatePipelineParams() (*jobs.PipelineParams, error) {
@@ -80,6 +122,7 @@ func (o *JobOptions) toPayload(jobID int64) (*jobs.RunNow, error) {
 		PythonParams:      o.pythonParams,
 		SparkSubmitParams: o.sparkSubmitParams,
 		SqlParams:         o.sqlParams,
+		JobParameters:     o.jobParams,
 	}
 
 	return payload, nil
@@ -151,14 +194,6 @@ func (r *jobRunner) logFailedTasks(ctx context.Context, runId int64) {
 	}
 }
 
-func pullRunIdCallback(runId *int64) func(info *jobs.Run) {
-	return func(i *jobs.Run) {
-		if *runId == 0 {
-			*runId = i.RunId
-		}
-	}
-}
-
 func logDebugCallback(ctx context.Context, runId *int64) func(info *jobs.Run) {
 	var prevState *jobs.RunState
 	return func(i *jobs.Run) {
@@ -213,16 +248,117 @@ func logProgressCallback(ctx context.Context, progressLogger *cmdio.Logger) func
 	}
 }
 
+// runNowOnly triggers a run of the specified tasks of the job.
+// The `only` field of the run-now request is not part of the SDK request type,
+// so the request is sent through the API client directly.
+func runNowOnly(ctx context.Context, w *databricks.WorkspaceClient, req *jobs.RunNow, only []string) (int64, error) {
+	apiClient, err := client.New(w.Config)
+	if err != nil {
+		return 0, err
+	}
+
+	buf, err := json.Marshal(req)
+	if err != nil {
+		return 0, err
+	}
+	var body map[string]any
+	err = json.Unmarshal(buf, &body)
+	if err != nil {
+		return 0, err
+	}
+	body["only"] = only
+
+	var resp jobs.RunNowResponse
+	err = apiClient.Do(ctx, http.MethodPost, "/api/2.1/jobs/run-now", nil, body, &resp)
+	if err != nil {
+		return 0, err
+	}
+	return resp.RunId, nil
+}
+
+// start triggers a new run of the job, or repairs the latest failed run,
+// and returns the ID of the run to wait for.
+func (r *jobRunner) start(ctx context.Context, jobID int64, opts *JobOptions) (int64, error) {
+	w := r.bundle.WorkspaceClient()
+
+	// construct request payload from cmd line flags args
+	req, err := opts.toPayload(jobID)
+	if err != nil {
+		return 0, err
+	}
+
+	if opts.repair {
+		return r.repairLatestRun(ctx, req, opts.only)
+	}
+
+	if len(opts.only) > 0 {
+		runId, err := runNowOnly(ctx, w, req, opts.only)
+		if err != nil {
+			return 0, fmt.Errorf("cannot start job: %w", err)
+		}
+		return runId, nil
+	}
+
+	waiter, err := w.Jobs.RunNow(ctx, *req)
+	if err != nil {
+		return 0, fmt.Errorf("cannot start job: %w", err)
+	}
+	return waiter.RunId, nil
+}
+
+// Default timeout for waiting for a job run to be canceled.
+var jobCancelTimeout time.Duration = 15 * time.Minute
+
+func (r *jobRunner) cancelRun(ctx context.Context, runId int64) error {
+	w := r.bundle.WorkspaceClient()
+	waiter, err := w.Jobs.CancelRun(ctx, jobs.CancelRun{
+		RunId: runId,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = waiter.GetWithTimeout(jobCancelTimeout)
+	return err
+}
+
+// Cancel cancels all active runs of the job and waits for them to terminate.
+func (r *jobRunner) Cancel(ctx context.Context) error {
+	jobID, err := strconv.ParseInt(r.job.ID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("job ID is not an integer: %s", r.job.ID)
+	}
+
+	w := r.bundle.WorkspaceClient()
+	runs, err := w.Jobs.ListRunsAll(ctx, jobs.ListRunsRequest{
+		JobId:      jobID,
+		ActiveOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, run := range runs {
+		cmdio.LogString(ctx, fmt.Sprintf("Cancelling run %d", run.RunId))
+		err := r.cancelRun(ctx, run.RunId)
+		if err != nil {
+			return fmt.Errorf("failed to cancel run %d: %w", run.RunId, err)
+		}
+	}
+	return nil
+}
+
 func (r *jobRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, error) {
 	jobID, err := strconv.ParseInt(r.job.ID, 10, 64)
 	if err != nil {
 		return nil, fmt.Errorf("job ID is not an integer: %s", r.job.ID)
 	}
 
-	runId := new(int64)
+	err = opts.Job.validateOnly(r.job)
+	if err != nil {
+		return nil, err
+	}
 
-	// construct request payload from cmd line flags args
-	req, err := opts.Job.toPayload(jobID)
+	err = opts.Job.validateJobParams(r.job)
 	if err != nil {
 		return nil, err
 	}
@@ -232,40 +368,63 @@ func (r *jobRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, e
 
 	w := r.bundle.WorkspaceClient()
 
-	// gets the run id from inside Jobs.RunNowAndWait
-	pullRunId := pullRunIdCallback(runId)
-
-	// callback to log status updates to the universal log destination.
-	// Called on every poll request
-	logDebug := logDebugCallback(ctx, runId)
-
 	// callback to log progress events. Called on every poll request
 	progressLogger, ok := cmdio.FromContext(ctx)
 	if !ok {
 		return nil, fmt.Errorf("no progress logger found")
 	}
-	logProgress := logProgressCallback(ctx, progressLogger)
 
-	waiter, err := w.Jobs.RunNow(ctx, *req)
+	runId, err := r.start(ctx, jobID, &opts.Job)
 	if err != nil {
-		return nil, fmt.Errorf("cannot start job")
+		return nil, err
 	}
 
+	// callback to log status updates to the universal log destination.
+	// Called on every poll request
+	logDebug := logDebugCallback(ctx, &runId)
+	logProgress := logProgressCallback(ctx, progressLogger)
+
 	if opts.NoWait {
 		details, err := w.Jobs.GetRun(ctx, jobs.GetRunRequest{
-			RunId: waiter.RunId,
+			RunId: runId,
 		})
+		if err != nil {
+			return nil, err
+		}
 		progressLogger.Log(progress.NewJobRunUrlEvent(details.RunPageUrl))
-		return nil, err
+		return nil, nil
+	}
+
+	// In follow mode, task state transitions and output are logged instead of
+	// the state of the run, which is updated in place and would overwrite them.
+	var follower *runFollower
+	if opts.Follow {
+		follower = newRunFollower(w, progressLogger)
 	}
 
-	run, err := waiter.OnProgress(func(r *jobs.Run) {
-		pullRunId(r)
+	// Offer to cancel the run if the user interrupts waiting for it.
+	waitCtx, stop := withInterrupt(ctx)
+	defer stop()
+
+	run, err := w.Jobs.WaitGetRunJobTerminatedOrSkipped(waitCtx, runId, jobRunTimeout, func(r *jobs.Run) {
 		logDebug(r)
-		logProgress(r)
-	}).GetWithTimeout(jobRunTimeout)
-	if err != nil && runId != nil {
-		r.logFailedTasks(ctx, *runId)
+		if follower != nil {
+			follower.OnProgress(ctx, r)
+		} else {
+			logProgress(r)
+		}
+	})
+	if isInterrupted(ctx, waitCtx) {
+		stop()
+		return nil, handleInterrupt(ctx, fmt.Sprintf("run %d", runId), func(ctx context.Context) error {
+			return r.cancelRun(ctx, runId)
+		})
+	}
+	if follower != nil {
+		follower.Summarize(ctx, runId)
+	}
+	if err != nil {
+		r.logFailedTasks(ctx, runId)
 	}
 	if err != nil {
 		return nil, err
@@ -289,7 +448,7 @@ func (r *jobRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, e
 	// The task completed successfully.
 	case jobs.RunResultStateSuccess:
 		log.Infof(ctx, "Run has completed successfully!")
-		return output.GetJobOutput(ctx, r.bundle.WorkspaceClient(), *runId)
+		return output.GetJobOutput(ctx, r.bundle.WorkspaceClient(), runId)
 
 	// The run was stopped after reaching the timeout.
 	case jobs.RunResultStateTimedout:
@@ -0,0 +1,189 @@
+package run
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle/config/resources"
+)
+
+// hasTaskParams returns true if any of the flags for task parameters is set.
+// The API does not allow combining task parameters with job parameters.
+func (o *JobOptions) hasTaskParams() bool {
+	return len(o.dbtCommands) > 0 ||
+		len(o.jarParams) > 0 ||
+		len(o.notebookParams) > 0 ||
+		len(o.pipelineParams) > 0 ||
+		len(o.pythonNamedParams) > 0 ||
+		len(o.pythonParams) > 0 ||
+		len(o.sparkSubmitParams) > 0 ||
+		len(o.sqlParams) > 0
+}
+
+func jobParameterNames(job *resources.Job) []string {
+	var names []string
+	if job.JobSettings != nil {
+		for _, p := range job.Parameters {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+// validateJobParams checks that the values specified with --params are
+// for parameters declared by the job.
+func (o *JobOptions) validateJobParams(job *resources.Job) error {
+	if len(o.jobParams) == 0 {
+		return nil
+	}
+
+	names := jobParameterNames(job)
+	if len(names) == 0 {
+		return fmt.Errorf("the job does not declare any parameters; specify task parameters through flags such as --notebook-params instead")
+	}
+
+	if o.hasTaskParams() {
+		return fmt.Errorf("job parameters cannot be combined with task parameters; the job declares parameters: %s", strings.Join(names, ", "))
+	}
+
+	var unknown []string
+	for k := range o.jobParams {
+		if !slices.Contains(names, k) {
+			unknown = append(unknown, k)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown job parameters: %s; the job declares parameters: %s", strings.Join(unknown, ", "), strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
+// parseKeyValueArgs parses arguments of the form `--key=value` or `--key value` into a map.
+func parseKeyValueArgs(args []string) (map[string]string, error) {
+	out := make(map[string]string)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "--") || len(arg) == 2 {
+			return nil, fmt.Errorf("unexpected argument %q; expected arguments of the form --key=value", arg)
+		}
+
+		k, v, ok := strings.Cut(arg[2:], "=")
+		if !ok {
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf("no value specified for argument %q", arg)
+			}
+			i++
+			v = args[i]
+		}
+		out[k] = v
+	}
+	return out, nil
+}
+
+// taskParamKinds returns the kinds of parameters that the tasks of the job take,
+// named after the corresponding flags.
+func taskParamKinds(job *resources.Job) []string {
+	var kinds []string
+	add := func(kind string) {
+		if !slices.Contains(kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	if job.JobSettings == nil {
+		return nil
+	}
+
+	for _, task := range job.Tasks {
+		switch {
+		case task.NotebookTask != nil:
+			add("notebook-params")
+		case task.SparkPythonTask != nil, task.PythonWheelTask != nil:
+			add("python-params")
+		case task.SparkJarTask != nil:
+			add("jar-params")
+		case task.SparkSubmitTask != nil:
+			add("spark-submit-params")
+		case task.SqlTask != nil:
+			add("sql-params")
+		case task.DbtTask != nil:
+			add("dbt-commands")
+		}
+	}
+
+	sort.Strings(kinds)
+	return kinds
+}
+
+// ParseArgs maps positional arguments to job parameters if the job declares
+// parameters. Otherwise, they are mapped to task parameters of the type that
+// the tasks in the job take.
+func (r *jobRunner) ParseArgs(args []string, opts *Options) error {
+	if len(args) == 0 {
+		return nil
+	}
+
+	o := &opts.Job
+	if len(jobParameterNames(r.job)) > 0 {
+		if len(o.jobParams) > 0 {
+			return fmt.Errorf("job parameters cannot be specified both as arguments and through --params")
+		}
+		params, err := parseKeyValueArgs(args)
+		if err != nil {
+			return err
+		}
+		o.jobParams = params
+		return nil
+	}
+
+	kinds := taskParamKinds(r.job)
+	switch len(kinds) {
+	case 0:
+		return fmt.Errorf("the tasks of the job do not take parameters; received %d unexpected positional arguments", len(args))
+	case 1:
+	default:
+		return fmt.Errorf("cannot map arguments to the parameters of tasks with different types; specify them through --%s instead", strings.Join(kinds, ", --"))
+	}
+
+	var err error
+	kind := kinds[0]
+	switch kind {
+	case "notebook-params":
+		err = setArgsMap(kind, &o.notebookParams, args)
+	case "sql-params":
+		err = setArgsMap(kind, &o.sqlParams, args)
+	case "python-params":
+		err = setArgsList(kind, &o.pythonParams, args)
+	case "jar-params":
+		err = setArgsList(kind, &o.jarParams, args)
+	case "spark-submit-params":
+		err = setArgsList(kind, &o.sparkSubmitParams, args)
+	case "dbt-commands":
+		err = setArgsList(kind, &o.dbtCommands, args)
+	}
+	return err
+}
+
+func setArgsMap(kind string, dst *map[string]string, args []string) error {
+	if len(*dst) > 0 {
+		return fmt.Errorf("task parameters cannot be specified both as arguments and through --%s", kind)
+	}
+	params, err := parseKeyValueArgs(args)
+	if err != nil {
+		return err
+	}
+	*dst = params
+	return nil
+}
+
+func setArgsList(kind string, dst *[]string, args []string) error {
+	if len(*dst) > 0 {
+		return fmt.Errorf("task parameters cannot be specified both as arguments and through --%s", kind)
+	}
+	*dst = args
+	return nil
+}
@@ -0,0 +1,108 @@
+package run
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func jobWithParameters(names ...string) *resources.Job {
+	job := &resources.Job{JobSettings: &jobs.JobSettings{}}
+	for _, name := range names {
+		job.Parameters = append(job.Parameters, jobs.JobParameterDefinition{Name: name})
+	}
+	return job
+}
+
+func jobWithTasks(tasks ...jobs.Task) *resources.Job {
+	return &resources.Job{JobSettings: &jobs.JobSettings{Tasks: tasks}}
+}
+
+func TestJobOptionsValidateJobParams(t *testing.T) {
+	job := jobWithParameters("env", "date")
+
+	opts := &JobOptions{jobParams: map[string]string{"env": "dev"}}
+	assert.NoError(t, opts.validateJobParams(job))
+
+	opts = &JobOptions{jobParams: map[string]string{"env": "dev", "foo": "bar", "baz": "qux"}}
+	assert.EqualError(t, opts.validateJobParams(job), "unknown job parameters: baz, foo; the job declares parameters: env, date")
+
+	opts = &JobOptions{jobParams: map[string]string{"env": "dev"}, notebookParams: map[string]string{"a": "b"}}
+	assert.ErrorContains(t, opts.validateJobParams(job), "job parameters cannot be combined with task parameters")
+
+	opts = &JobOptions{jobParams: map[string]string{"env": "dev"}}
+	assert.ErrorContains(t, opts.validateJobParams(jobWithParameters()), "the job does not declare any parameters")
+}
+
+func TestJobRunnerParseArgsJobParameters(t *testing.T) {
+	r := &jobRunner{job: jobWithParameters("env", "date")}
+
+	opts := &Options{}
+	err := r.ParseArgs([]string{"--env=dev", "--date", "2024-01-01"}, opts)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "dev", "date": "2024-01-01"}, opts.Job.jobParams)
+
+	opts = &Options{}
+	err = r.ParseArgs([]string{"env=dev"}, opts)
+	assert.EqualError(t, err, `unexpected argument "env=dev"; expected arguments of the form --key=value`)
+
+	opts = &Options{}
+	err = r.ParseArgs([]string{"--env"}, opts)
+	assert.EqualError(t, err, `no value specified for argument "--env"`)
+
+	opts = &Options{Job: JobOptions{jobParams: map[string]string{"env": "prod"}}}
+	err = r.ParseArgs([]string{"--env=dev"}, opts)
+	assert.ErrorContains(t, err, "job parameters cannot be specified both as arguments and through --params")
+}
+
+func TestJobRunnerParseArgsTaskParameters(t *testing.T) {
+	r := &jobRunner{job: jobWithTasks(
+		jobs.Task{TaskKey: "a", NotebookTask: &jobs.NotebookTask{}},
+		jobs.Task{TaskKey: "b", NotebookTask: &jobs.NotebookTask{}},
+	)}
+	opts := &Options{}
+	require.NoError(t, r.ParseArgs([]string{"--foo=bar"}, opts))
+	assert.Equal(t, map[string]string{"foo": "bar"}, opts.Job.notebookParams)
+
+	r = &jobRunner{job: jobWithTasks(
+		jobs.Task{TaskKey: "a", SparkPythonTask: &jobs.SparkPythonTask{}},
+		jobs.Task{TaskKey: "b", PythonWheelTask: &jobs.PythonWheelTask{}},
+	)}
+	opts = &Options{}
+	require.NoError(t, r.ParseArgs([]string{"--foo", "bar"}, opts))
+	assert.Equal(t, []string{"--foo", "bar"}, opts.Job.pythonParams)
+
+	r = &jobRunner{job: jobWithTasks(
+		jobs.Task{TaskKey: "a", SparkJarTask: &jobs.SparkJarTask{}},
+	)}
+	opts = &Options{}
+	require.NoError(t, r.ParseArgs([]string{"foo", "bar"}, opts))
+	assert.Equal(t, []string{"foo", "bar"}, opts.Job.jarParams)
+}
+
+func TestJobRunnerParseArgsTaskParametersErrors(t *testing.T) {
+	r := &jobRunner{job: jobWithTasks(
+		jobs.Task{TaskKey: "a", NotebookTask: &jobs.NotebookTask{}},
+		jobs.Task{TaskKey: "b", SparkJarTask: &jobs.SparkJarTask{}},
+	)}
+	err := r.ParseArgs([]string{"foo"}, &Options{})
+	assert.EqualError(t, err, "cannot map arguments to the parameters of tasks with different types; specify them through --jar-params, --notebook-params instead")
+
+	r = &jobRunner{job: jobWithTasks(
+		jobs.Task{TaskKey: "a", PipelineTask: &jobs.PipelineTask{}},
+	)}
+	err = r.ParseArgs([]string{"foo"}, &Options{})
+	assert.EqualError(t, err, "the tasks of the job do not take parameters; received 1 unexpected positional arguments")
+
+	r = &jobRunner{job: jobWithTasks(
+		jobs.Task{TaskKey: "a", SparkJarTask: &jobs.SparkJarTask{}},
+	)}
+	err = r.ParseArgs([]string{"foo"}, &Options{Job: JobOptions{jarParams: []string{"bar"}}})
+	assert.EqualError(t, err, "task parameters cannot be specified both as arguments and through --jar-params")
+
+	// No arguments is always fine.
+	assert.NoError(t, r.ParseArgs(nil, &Options{}))
+}
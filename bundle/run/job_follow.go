@@ -0,0 +1,142 @@
+package run
+
+import (
+	"context"
+	"strings"
+
+	"github.com/databricks/cli/bundle/run/progress"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+// runFollower logs state transitions and new output of the tasks of a run
+// every time the run is polled.
+type runFollower struct {
+	w      *databricks.WorkspaceClient
+	logger *cmdio.Logger
+
+	// Whether the run URL has been logged.
+	loggedUrl bool
+
+	// Last logged state of every task, by task run ID.
+	states map[int64]jobs.RunState
+
+	// Output that has been logged for every task, by task run ID.
+	logs map[int64]string
+}
+
+func newRunFollower(w *databricks.WorkspaceClient, logger *cmdio.Logger) *runFollower {
+	return &runFollower{
+		w:      w,
+		logger: logger,
+		states: make(map[int64]jobs.RunState),
+		logs:   make(map[int64]string),
+	}
+}
+
+// Minimum length of the overlap between previous and current output
+// for the current output to be considered a continuation of the previous output.
+const followMinOverlap = 256
+
+// newOutput returns the part of the current output that has not been logged before.
+// The API returns a bounded tail of the output, so the start of the previous output
+// may have been dropped from the current output. In that case, the current output
+// starts at some offset in the previous output and continues past its end.
+func newOutput(prev, cur string) string {
+	if strings.HasPrefix(cur, prev) {
+		return cur[len(prev):]
+	}
+
+	// Short overlaps are likely to be coincidental.
+	minOverlap := min(len(prev)/2, followMinOverlap)
+
+	if cur != "" {
+		for off := 0; off < len(prev); off++ {
+			i := strings.IndexByte(prev[off:], cur[0])
+			if i < 0 {
+				break
+			}
+			off += i
+			if len(prev)-off < minOverlap {
+				break
+			}
+			if strings.HasPrefix(cur, prev[off:]) {
+				return cur[len(prev)-off:]
+			}
+		}
+	}
+
+	// No overlap; all of the current output is new.
+	return cur
+}
+
+func (f *runFollower) logOutput(ctx context.Context, task jobs.RunTask, final bool) {
+	out, err := f.w.Jobs.GetRunOutput(ctx, jobs.GetRunOutputRequest{
+		RunId: task.RunId,
+	})
+	if err != nil {
+		log.Debugf(ctx, "Unable to fetch output of task %s: %s", task.TaskKey, err)
+		return
+	}
+
+	prev := f.logs[task.RunId]
+	if delta := newOutput(prev, out.Logs); delta != "" {
+		f.logger.Log(progress.NewTaskOutputEvent(task.TaskKey, delta))
+	}
+	f.logs[task.RunId] = out.Logs
+
+	// The result of a notebook is only available when it completes.
+	if final && out.NotebookOutput != nil && out.NotebookOutput.Result != "" {
+		f.logger.Log(progress.NewTaskOutputEvent(task.TaskKey, out.NotebookOutput.Result))
+	}
+}
+
+// OnProgress is called every time the run is polled.
+func (f *runFollower) OnProgress(ctx context.Context, run *jobs.Run) {
+	if !f.loggedUrl && run.RunPageUrl != "" {
+		f.logger.Log(progress.NewJobRunUrlEvent(run.RunPageUrl))
+		f.loggedUrl = true
+	}
+
+	for _, task := range run.Tasks {
+		if task.State == nil {
+			continue
+		}
+
+		prev, seen := f.states[task.RunId]
+		changed := !seen ||
+			prev.LifeCycleState != task.State.LifeCycleState ||
+			prev.ResultState != task.State.ResultState
+		if changed {
+			f.logger.Log(progress.NewTaskStateEvent(task.TaskKey, task.RunId, *task.State))
+			f.states[task.RunId] = *task.State
+		}
+
+		switch task.State.LifeCycleState {
+		case jobs.RunLifeCycleStateRunning, jobs.RunLifeCycleStateTerminating:
+			f.logOutput(ctx, task, false)
+		case jobs.RunLifeCycleStateTerminated, jobs.RunLifeCycleStateInternalError:
+			// Fetch the output of a task one last time when it completes.
+			if changed {
+				f.logOutput(ctx, task, true)
+			}
+		}
+	}
+}
+
+// Summarize logs the final state of the tasks of the run.
+func (f *runFollower) Summarize(ctx context.Context, runId int64) {
+	run, err := f.w.Jobs.GetRun(ctx, jobs.GetRunRequest{
+		RunId: runId,
+	})
+	if err != nil {
+		log.Errorf(ctx, "Unable to fetch the state of run %d: %s", runId, err)
+		return
+	}
+
+	// Log output and transitions that happened after the last poll.
+	f.OnProgress(ctx, run)
+	f.logger.Log(progress.NewJobRunSummaryEvent(run))
+}
@@ -0,0 +1,74 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/qa"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOutput(t *testing.T) {
+	assert.Equal(t, "hello", newOutput("", "hello"))
+	assert.Equal(t, " world", newOutput("hello", "hello world"))
+	assert.Equal(t, "", newOutput("hello", "hello"))
+
+	// The start of the output was dropped.
+	assert.Equal(t, "\nline 4", newOutput("line 1\nline 2\nline 3", "line 2\nline 3\nline 4"))
+
+	// No overlap with the previous output.
+	assert.Equal(t, "other", newOutput("hello", "other"))
+}
+
+func TestRunFollowerOnProgress(t *testing.T) {
+	r := testJobRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/runs/get-output?run_id=11",
+			Response: jobs.RunOutput{Logs: "line 1\n"},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/runs/get-output?run_id=11",
+			Response: jobs.RunOutput{Logs: "line 1\nline 2\n"},
+		},
+	})
+
+	var buf bytes.Buffer
+	logger := cmdio.NewLogger(flags.ModeAppend)
+	logger.Writer = &buf
+
+	f := newRunFollower(r.bundle.WorkspaceClient(), logger)
+	run := func(state jobs.RunLifeCycleState) *jobs.Run {
+		return &jobs.Run{
+			RunId:      10,
+			RunPageUrl: "https://host/#job/1/run/10",
+			Tasks: []jobs.RunTask{
+				{TaskKey: "task_a", RunId: 11, State: &jobs.RunState{LifeCycleState: state}},
+			},
+		}
+	}
+
+	ctx := context.Background()
+	f.OnProgress(ctx, run(jobs.RunLifeCycleStatePending))
+	f.OnProgress(ctx, run(jobs.RunLifeCycleStateRunning))
+	f.OnProgress(ctx, run(jobs.RunLifeCycleStateRunning))
+
+	var lines []string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	assert.Len(t, lines, 5)
+	assert.Equal(t, "Run URL: https://host/#job/1/run/10", lines[0])
+	assert.Contains(t, lines[1], "Task task_a PENDING")
+	assert.Contains(t, lines[2], "Task task_a RUNNING")
+	assert.Equal(t, "[task_a] line 1", lines[3])
+	assert.Equal(t, "[task_a] line 2", lines[4])
+}
@@ -0,0 +1,111 @@
+package run
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+// Maximum number of completed runs to inspect when looking for the latest failed run.
+const maxRepairCandidates = 100
+
+func isFailedRun(state *jobs.RunState) bool {
+	if state == nil {
+		return false
+	}
+	if state.LifeCycleState == jobs.RunLifeCycleStateInternalError {
+		return true
+	}
+	switch state.ResultState {
+	case jobs.RunResultStateFailed, jobs.RunResultStateTimedout, jobs.RunResultStateCanceled:
+		return true
+	}
+	return false
+}
+
+// latestFailedRun returns the ID of the most recent completed run of the job that did not succeed.
+func (r *jobRunner) latestFailedRun(ctx context.Context, jobID int64) (int64, error) {
+	w := r.bundle.WorkspaceClient()
+	it := w.Jobs.ListRuns(ctx, jobs.ListRunsRequest{
+		JobId:         jobID,
+		CompletedOnly: true,
+	})
+
+	for i := 0; i < maxRepairCandidates && it.HasNext(ctx); i++ {
+		run, err := it.Next(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if isFailedRun(run.State) {
+			return run.RunId, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no failed run found in the latest %d runs of job %d", maxRepairCandidates, jobID)
+}
+
+// latestRepairId returns the ID of the latest repair of the run, or 0 if the run has not been repaired.
+// Repairing a run that has been repaired before requires this ID.
+func (r *jobRunner) latestRepairId(ctx context.Context, runId int64) (int64, error) {
+	w := r.bundle.WorkspaceClient()
+	run, err := w.Jobs.GetRun(ctx, jobs.GetRunRequest{
+		RunId: runId,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	for _, item := range run.RepairHistory {
+		if item.Type == jobs.RepairHistoryItemTypeRepair {
+			id = item.Id
+		}
+	}
+	return id, nil
+}
+
+// repairLatestRun reruns the failed tasks of the latest failed run of the job,
+// or the specified tasks if any, and returns the ID of the repaired run.
+func (r *jobRunner) repairLatestRun(ctx context.Context, req *jobs.RunNow, only []string) (int64, error) {
+	runId, err := r.latestFailedRun(ctx, req.JobId)
+	if err != nil {
+		return 0, err
+	}
+
+	repairId, err := r.latestRepairId(ctx, runId)
+	if err != nil {
+		return 0, err
+	}
+
+	repair := jobs.RepairRun{
+		RunId:          runId,
+		LatestRepairId: repairId,
+
+		DbtCommands:       req.DbtCommands,
+		JarParams:         req.JarParams,
+		NotebookParams:    req.NotebookParams,
+		PipelineParams:    req.PipelineParams,
+		PythonNamedParams: req.PythonNamedParams,
+		PythonParams:      req.PythonParams,
+		SparkSubmitParams: req.SparkSubmitParams,
+		SqlParams:         req.SqlParams,
+		JobParameters:     req.JobParameters,
+	}
+
+	if len(only) > 0 {
+		repair.RerunTasks = only
+	} else {
+		repair.RerunAllFailedTasks = true
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Repairing run %d", runId))
+
+	w := r.bundle.WorkspaceClient()
+	_, err = w.Jobs.RepairRun(ctx, repair)
+	if err != nil {
+		return 0, fmt.Errorf("cannot repair run %d: %w", runId, err)
+	}
+	return runId, nil
+}
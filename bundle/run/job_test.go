@@ -0,0 +1,175 @@
+package run
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/internal/bundletest"
+	"github.com/databricks/databricks-sdk-go/qa"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testJobRunner(t *testing.T, fixtures qa.HTTPFixtures) *jobRunner {
+	job := &resources.Job{
+		ID: "123",
+		JobSettings: &jobs.JobSettings{
+			Tasks: []jobs.Task{
+				{TaskKey: "task_a"},
+				{TaskKey: "task_b"},
+			},
+		},
+	}
+
+	b := bundletest.WithFixtures(t, fixtures, config.Resources{
+		Jobs: map[string]*resources.Job{
+			"my_job": job,
+		},
+	})
+
+	return &jobRunner{key: "jobs.my_job", bundle: b, job: job}
+}
+
+func TestJobOptionsValidateOnly(t *testing.T) {
+	job := &resources.Job{
+		JobSettings: &jobs.JobSettings{
+			Tasks: []jobs.Task{
+				{TaskKey: "task_a"},
+				{TaskKey: "task_b"},
+			},
+		},
+	}
+
+	opts := &JobOptions{only: []string{"task_b"}}
+	assert.NoError(t, opts.validateOnly(job))
+
+	opts = &JobOptions{only: []string{"task_a", "task_c"}}
+	assert.EqualError(t, opts.validateOnly(job), `task "task_c" is not defined; available tasks: task_a, task_b`)
+
+	opts = &JobOptions{only: []string{"task_a"}}
+	assert.EqualError(t, opts.validateOnly(&resources.Job{}), `task "task_a" is not defined; the job does not define any tasks`)
+}
+
+func TestJobRunnerStartOnly(t *testing.T) {
+	r := testJobRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "POST",
+			Resource: "/api/2.1/jobs/run-now",
+			ExpectedRequest: map[string]any{
+				"job_id": 123,
+				"only":   []string{"task_a"},
+			},
+			Response: jobs.RunNowResponse{RunId: 456},
+		},
+	})
+
+	runId, err := r.start(context.Background(), 123, &JobOptions{only: []string{"task_a"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(456), runId)
+}
+
+func TestJobRunnerStartRepair(t *testing.T) {
+	r := testJobRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/runs/list?completed_only=true&job_id=123",
+			Response: jobs.ListRunsResponse{
+				Runs: []jobs.BaseRun{
+					{RunId: 3, State: &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateTerminated, ResultState: jobs.RunResultStateSuccess}},
+					{RunId: 2, State: &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateTerminated, ResultState: jobs.RunResultStateFailed}},
+					{RunId: 1, State: &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateTerminated, ResultState: jobs.RunResultStateFailed}},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/runs/get?run_id=2",
+			Response: jobs.Run{
+				RunId: 2,
+				RepairHistory: []jobs.RepairHistoryItem{
+					{Type: jobs.RepairHistoryItemTypeOriginal, Id: 2},
+					{Type: jobs.RepairHistoryItemTypeRepair, Id: 42},
+				},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.1/jobs/runs/repair",
+			ExpectedRequest: jobs.RepairRun{
+				RunId:               2,
+				LatestRepairId:      42,
+				RerunAllFailedTasks: true,
+			},
+			Response: jobs.RepairRunResponse{RepairId: 43},
+		},
+	})
+
+	runId, err := r.start(context.Background(), 123, &JobOptions{repair: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), runId)
+}
+
+func TestJobRunnerStartRepairNoFailedRun(t *testing.T) {
+	r := testJobRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/runs/list?completed_only=true&job_id=123",
+			Response: jobs.ListRunsResponse{
+				Runs: []jobs.BaseRun{
+					{RunId: 1, State: &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateTerminated, ResultState: jobs.RunResultStateSuccess}},
+				},
+			},
+		},
+	})
+
+	_, err := r.start(context.Background(), 123, &JobOptions{repair: true})
+	assert.EqualError(t, err, "no failed run found in the latest 100 runs of job 123")
+}
+
+func TestJobRunnerCancel(t *testing.T) {
+	r := testJobRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=123",
+			Response: jobs.ListRunsResponse{
+				Runs: []jobs.BaseRun{
+					{RunId: 1, State: &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateRunning}},
+				},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.1/jobs/runs/cancel",
+			ExpectedRequest: jobs.CancelRun{
+				RunId: 1,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/runs/get?run_id=1",
+			Response: jobs.Run{
+				RunId: 1,
+				State: &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateTerminated, ResultState: jobs.RunResultStateCanceled},
+			},
+		},
+	})
+
+	err := r.Cancel(context.Background())
+	require.NoError(t, err)
+}
+
+func TestJobRunnerCancelNoActiveRuns(t *testing.T) {
+	r := testJobRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/runs/list?active_only=true&job_id=123",
+			Response: jobs.ListRunsResponse{},
+		},
+	})
+
+	err := r.Cancel(context.Background())
+	require.NoError(t, err)
+}
@@ -30,6 +30,12 @@ func ResourceKeys(b *bundle.Bundle) (keyOnly RunnerLookup, keyWithType RunnerLoo
 		keyOnly[k] = append(keyOnly[k], &w)
 		keyWithType[kt] = append(keyWithType[kt], &w)
 	}
+	for k, v := range r.ModelServingEndpoints {
+		kt := fmt.Sprintf("model_serving_endpoints.%s", k)
+		w := servingEndpointRunner{key: key(kt), bundle: b, endpoint: v}
+		keyOnly[k] = append(keyOnly[k], &w)
+		keyWithType[kt] = append(keyWithType[kt], &w)
+	}
 	return
 }
 
@@ -7,10 +7,17 @@ import (
 type Options struct {
 	Job      JobOptions
 	Pipeline PipelineOptions
-	NoWait   bool
+
+	ServingEndpoint ServingEndpointOptions
+
+	NoWait bool
+
+	// Log state transitions and output of tasks while waiting for a run to complete.
+	Follow bool
 }
 
 func (o *Options) Define(fs *flag.FlagSet) {
 	o.Job.Define(fs)
 	o.Pipeline.Define(fs)
+	o.ServingEndpoint.Define(fs)
 }
@@ -0,0 +1,38 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type ServingEndpointOutput struct {
+	// Response of the endpoint to the request.
+	Response json.RawMessage `json:"response"`
+
+	// Time between sending the request and receiving the response.
+	Latency time.Duration `json:"-"`
+
+	// Latency in milliseconds, for use in JSON output.
+	LatencyMs int64 `json:"latency_ms"`
+}
+
+func NewServingEndpointOutput(response []byte, latency time.Duration) *ServingEndpointOutput {
+	return &ServingEndpointOutput{
+		Response:  response,
+		Latency:   latency,
+		LatencyMs: latency.Milliseconds(),
+	}
+}
+
+func (out *ServingEndpointOutput) String() (string, error) {
+	var buf bytes.Buffer
+	err := json.Indent(&buf, out.Response, "", "  ")
+	if err != nil {
+		// The response is not valid JSON; print it as is.
+		buf.Reset()
+		buf.Write(out.Response)
+	}
+	return fmt.Sprintf("%s\nLatency: %s\n", buf.String(), out.Latency.Round(time.Millisecond)), nil
+}
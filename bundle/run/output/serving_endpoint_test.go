@@ -0,0 +1,24 @@
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServingEndpointOutputString(t *testing.T) {
+	out := NewServingEndpointOutput([]byte(`{"predictions":[1]}`), 1234567*time.Microsecond)
+	s, err := out.String()
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"predictions\": [\n    1\n  ]\n}\nLatency: 1.235s\n", s)
+	assert.Equal(t, int64(1234), out.LatencyMs)
+}
+
+func TestServingEndpointOutputStringNotJSON(t *testing.T) {
+	out := NewServingEndpointOutput([]byte(`hello`), 5*time.Millisecond)
+	s, err := out.String()
+	require.NoError(t, err)
+	assert.Equal(t, "hello\nLatency: 5ms\n", s)
+}
@@ -2,7 +2,9 @@ package run
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -12,6 +14,8 @@ import (
 	"github.com/databricks/cli/bundle/run/progress"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/client"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
 	flag "github.com/spf13/pflag"
 )
@@ -19,29 +23,14 @@ import (
 func filterEventsByUpdateId(events []pipelines.PipelineEvent, updateId string) []pipelines.PipelineEvent {
 	result := []pipelines.PipelineEvent{}
 	for i := 0; i < len(events); i++ {
-		if events[i].Origin.UpdateId == updateId {
+		if events[i].Origin != nil && events[i].Origin.UpdateId == updateId {
 			result = append(result, events[i])
 		}
 	}
 	return result
 }
 
-func (r *pipelineRunner) logEvent(ctx context.Context, event pipelines.PipelineEvent) {
-	logString := ""
-	if event.Message != "" {
-		logString += fmt.Sprintf(" %s\n", event.Message)
-	}
-	if event.Error != nil && len(event.Error.Exceptions) > 0 {
-		logString += "trace for most recent exception: \n"
-		for i := 0; i < len(event.Error.Exceptions); i++ {
-			logString += fmt.Sprintf("%s\n", event.Error.Exceptions[i].Message)
-		}
-	}
-	if logString != "" {
-		log.Errorf(ctx, fmt.Sprintf("[%s] %s", event.EventType, logString))
-	}
-}
-
+// logErrorEvent logs the errors reported by the update, including the datasets they originate from.
 func (r *pipelineRunner) logErrorEvent(ctx context.Context, pipelineId string, updateId string) error {
 	w := r.bundle.WorkspaceClient()
 
@@ -62,11 +51,17 @@ func (r *pipelineRunner) logErrorEvent(ctx context.Context, pipelineId string, u
 	if err != nil {
 		return err
 	}
+	progressLogger, ok := cmdio.FromContext(ctx)
+	if !ok {
+		return fmt.Errorf("no progress logger found")
+	}
 	updateEvents := filterEventsByUpdateId(res.Events, updateId)
 	// The events API returns most recent events first. We iterate in a reverse order
 	// to print the events chronologically
 	for i := len(updateEvents) - 1; i >= 0; i-- {
-		r.logEvent(ctx, updateEvents[i])
+		event := progress.NewPipelineErrorEvent(updateEvents[i])
+		progressLogger.Log(event)
+		log.Errorf(ctx, event.String())
 	}
 	return nil
 }
@@ -84,13 +79,17 @@ type PipelineOptions struct {
 
 	// List of tables to reset and recompute.
 	FullRefresh []string
+
+	// Perform an update to validate graph correctness, without materializing or publishing any datasets.
+	ValidateOnly bool
 }
 
 func (o *PipelineOptions) Define(fs *flag.FlagSet) {
 	fs.BoolVar(&o.RefreshAll, "refresh-all", false, "Perform a full graph update.")
-	fs.StringSliceVar(&o.Refresh, "refresh", nil, "List of tables to update.")
+	fs.StringSliceVar(&o.Refresh, "refresh", nil, "List of tables to update. Glob patterns (e.g. \"bronze_*\") select all matching datasets.")
 	fs.BoolVar(&o.FullRefreshAll, "full-refresh-all", false, "Perform a full graph reset and recompute.")
-	fs.StringSliceVar(&o.FullRefresh, "full-refresh", nil, "List of tables to reset and recompute.")
+	fs.StringSliceVar(&o.FullRefresh, "full-refresh", nil, "List of tables to reset and recompute. Glob patterns (e.g. \"bronze_*\") select all matching datasets.")
+	fs.BoolVar(&o.ValidateOnly, "validate-only", false, "Perform an update to validate graph correctness, without processing any data.")
 }
 
 // Validate returns if the combination of options is valid.
@@ -108,6 +107,9 @@ func (o *PipelineOptions) Validate() error {
 	if len(o.FullRefresh) > 0 {
 		set = append(set, "--full-refresh")
 	}
+	if o.ValidateOnly {
+		set = append(set, "--validate-only")
+	}
 	if len(set) > 1 {
 		return fmt.Errorf("pipeline run arguments are mutually exclusive (got %s)", strings.Join(set, ", "))
 	}
@@ -129,6 +131,54 @@ func (o *PipelineOptions) toPayload(pipelineID string) (*pipelines.StartUpdate,
 	return payload, nil
 }
 
+// startUpdateValidateOnly starts an update that only validates the pipeline graph.
+// The `validate_only` field of the update request is not part of the SDK request type,
+// so the request is sent through the API client directly.
+func startUpdateValidateOnly(ctx context.Context, w *databricks.WorkspaceClient, req *pipelines.StartUpdate) (*pipelines.StartUpdateResponse, error) {
+	apiClient, err := client.New(w.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	var body map[string]any
+	err = json.Unmarshal(buf, &body)
+	if err != nil {
+		return nil, err
+	}
+	body["validate_only"] = true
+
+	var resp pipelines.StartUpdateResponse
+	path := fmt.Sprintf("/api/2.0/pipelines/%s/updates", req.PipelineId)
+	err = apiClient.Do(ctx, http.MethodPost, path, nil, body, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (r *pipelineRunner) startUpdate(ctx context.Context, opts *PipelineOptions) (string, error) {
+	w := r.bundle.WorkspaceClient()
+	req, err := opts.toPayload(r.pipeline.ID)
+	if err != nil {
+		return "", err
+	}
+
+	var res *pipelines.StartUpdateResponse
+	if opts.ValidateOnly {
+		res, err = startUpdateValidateOnly(ctx, w, req)
+	} else {
+		res, err = w.Pipelines.StartUpdate(ctx, *req)
+	}
+	if err != nil {
+		return "", err
+	}
+	return res.UpdateId, nil
+}
+
 type pipelineRunner struct {
 	key
 
@@ -143,30 +193,51 @@ func (r *pipelineRunner) Name() string {
 	return r.pipeline.PipelineSpec.Name
 }
 
+func (r *pipelineRunner) ParseArgs(args []string, opts *Options) error {
+	if len(args) == 0 {
+		return nil
+	}
+	return fmt.Errorf("received %d unexpected positional arguments", len(args))
+}
+
+// Default timeout for waiting for a pipeline to stop.
+var pipelineStopTimeout time.Duration = 20 * time.Minute
+
+// Cancel stops the update of the pipeline that is in progress, if any, and waits for the pipeline to be idle.
+func (r *pipelineRunner) Cancel(ctx context.Context) error {
+	w := r.bundle.WorkspaceClient()
+	waiter, err := w.Pipelines.Stop(ctx, pipelines.StopRequest{
+		PipelineId: r.pipeline.ID,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = waiter.GetWithTimeout(pipelineStopTimeout)
+	return err
+}
+
 func (r *pipelineRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, error) {
 	var pipelineID = r.pipeline.ID
 
 	// Include resource key in logger.
 	ctx = log.NewContext(ctx, log.GetLogger(ctx).With("resource", r.Key()))
 	w := r.bundle.WorkspaceClient()
-	_, err := w.Pipelines.GetByPipelineId(ctx, pipelineID)
+	pipeline, err := w.Pipelines.GetByPipelineId(ctx, pipelineID)
 	if err != nil {
 		log.Warnf(ctx, "Cannot get pipeline: %s", err)
 		return nil, err
 	}
 
-	req, err := opts.Pipeline.toPayload(pipelineID)
+	err = opts.Pipeline.resolvePatterns(ctx, w, pipeline)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := w.Pipelines.StartUpdate(ctx, *req)
+	updateID, err := r.startUpdate(ctx, &opts.Pipeline)
 	if err != nil {
 		return nil, err
 	}
 
-	updateID := res.UpdateId
-
 	// setup progress logger and tracker to query events
 	updateTracker := progress.NewUpdateTracker(pipelineID, updateID, w)
 	progressLogger, ok := cmdio.FromContext(ctx)
@@ -181,11 +252,22 @@ func (r *pipelineRunner) Run(ctx context.Context, opts *Options) (output.RunOutp
 		return nil, nil
 	}
 
+	// Offer to stop the update if the user interrupts waiting for it.
+	waitCtx, stop := withInterrupt(ctx)
+	defer stop()
+	interrupted := func() error {
+		stop()
+		return handleInterrupt(ctx, fmt.Sprintf("update %s", updateID), r.Cancel)
+	}
+
 	// Poll update for completion and post status.
 	// Note: there is no "StartUpdateAndWait" wrapper for this API.
 	var prevState *pipelines.UpdateInfoState
 	for {
-		events, err := updateTracker.Events(ctx)
+		events, err := updateTracker.Events(waitCtx)
+		if isInterrupted(ctx, waitCtx) {
+			return nil, interrupted()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -194,7 +276,10 @@ func (r *pipelineRunner) Run(ctx context.Context, opts *Options) (output.RunOutp
 			log.Infof(ctx, event.String())
 		}
 
-		update, err := w.Pipelines.GetUpdateByPipelineIdAndUpdateId(ctx, pipelineID, updateID)
+		update, err := w.Pipelines.GetUpdateByPipelineIdAndUpdateId(waitCtx, pipelineID, updateID)
+		if isInterrupted(ctx, waitCtx) {
+			return nil, interrupted()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -216,13 +301,26 @@ func (r *pipelineRunner) Run(ctx context.Context, opts *Options) (output.RunOutp
 			if err != nil {
 				return nil, err
 			}
+			if opts.Pipeline.ValidateOnly {
+				return nil, fmt.Errorf("validation failed")
+			}
 			return nil, fmt.Errorf("update failed")
 		}
 		if state == pipelines.UpdateInfoStateCompleted {
 			log.Infof(ctx, "Update has completed successfully!")
+			if opts.Pipeline.ValidateOnly {
+				cmdio.LogString(ctx, "Validation completed successfully; no errors found")
+			}
 			return nil, nil
 		}
 
-		time.Sleep(time.Second)
+		select {
+		case <-waitCtx.Done():
+			if isInterrupted(ctx, waitCtx) {
+				return nil, interrupted()
+			}
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+		}
 	}
 }
@@ -0,0 +1,152 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+)
+
+// Maximum number of datasets to include in the error for a pattern that matches nothing.
+const maxDatasetCandidates = 20
+
+// isPattern returns true if the table selection is a glob pattern rather than a table name.
+func isPattern(s string) bool {
+	return strings.ContainsAny(s, "*?[")
+}
+
+func hasPatterns(selection []string) bool {
+	for _, s := range selection {
+		if isPattern(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandSelection replaces the glob patterns in a table selection with the names
+// of the datasets they match. Names that are not patterns are included as is.
+func expandSelection(selection []string, datasets []string) ([]string, error) {
+	var out []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			out = append(out, name)
+		}
+	}
+
+	for _, s := range selection {
+		if !isPattern(s) {
+			add(s)
+			continue
+		}
+
+		matched := false
+		for _, dataset := range datasets {
+			ok, err := path.Match(s, dataset)
+			if err != nil {
+				return nil, fmt.Errorf("invalid table pattern %q: %w", s, err)
+			}
+			if ok {
+				matched = true
+				add(dataset)
+			}
+		}
+		if !matched {
+			names := datasets
+			if len(names) > maxDatasetCandidates {
+				names = append(names[:maxDatasetCandidates:maxDatasetCandidates], fmt.Sprintf("and %d more", len(names)-maxDatasetCandidates))
+			}
+			return nil, fmt.Errorf("table pattern %q does not match any dataset; available datasets: %s", s, strings.Join(names, ", "))
+		}
+	}
+	return out, nil
+}
+
+// Number of pipeline events to request per page when searching for dataset names.
+const datasetEventsPageSize = 100
+
+// listDatasets returns the names of the datasets defined by the pipeline, sorted by name.
+// The pipeline graph is only known to the backend, so the names are taken from the
+// flow definition events of the most recent update that defined any datasets.
+func listDatasets(ctx context.Context, w *databricks.WorkspaceClient, pipeline *pipelines.GetPipelineResponse) ([]string, error) {
+	req := pipelines.ListPipelineEventsRequest{
+		Filter:     `event_type='flow_definition'`,
+		MaxResults: datasetEventsPageSize,
+		PipelineId: pipeline.PipelineId,
+	}
+
+	// The events API returns the most recent events first, so the definitions of the
+	// latest update come first. We page through the events until we see a definition
+	// of an earlier update instead of listing the full event history of the pipeline.
+	var updateId string
+	var datasets []string
+	seen := make(map[string]bool)
+	for {
+		res, err := w.Pipelines.Impl().ListPipelineEvents(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range res.Events {
+			if event.EventType != "flow_definition" || event.Origin == nil || event.Origin.DatasetName == "" {
+				continue
+			}
+			if updateId == "" {
+				updateId = event.Origin.UpdateId
+			}
+			if event.Origin.UpdateId != updateId {
+				sort.Strings(datasets)
+				return datasets, nil
+			}
+			if !seen[event.Origin.DatasetName] {
+				seen[event.Origin.DatasetName] = true
+				datasets = append(datasets, event.Origin.DatasetName)
+			}
+		}
+
+		if res.NextPageToken == "" {
+			break
+		}
+
+		// The page token cannot be combined with a filter.
+		req = pipelines.ListPipelineEventsRequest{
+			MaxResults: datasetEventsPageSize,
+			PageToken:  res.NextPageToken,
+			PipelineId: pipeline.PipelineId,
+		}
+	}
+
+	if len(datasets) == 0 {
+		return nil, fmt.Errorf("cannot resolve table patterns; the pipeline has no updates that define datasets")
+	}
+	sort.Strings(datasets)
+	return datasets, nil
+}
+
+// resolvePatterns expands the glob patterns in the table selection of the options.
+func (o *PipelineOptions) resolvePatterns(ctx context.Context, w *databricks.WorkspaceClient, pipeline *pipelines.GetPipelineResponse) error {
+	if !hasPatterns(o.Refresh) && !hasPatterns(o.FullRefresh) {
+		return nil
+	}
+
+	datasets, err := listDatasets(ctx, w, pipeline)
+	if err != nil {
+		return err
+	}
+
+	o.Refresh, err = expandSelection(o.Refresh, datasets)
+	if err != nil {
+		return err
+	}
+	o.FullRefresh, err = expandSelection(o.FullRefresh, datasets)
+	if err != nil {
+		return err
+	}
+	return nil
+}
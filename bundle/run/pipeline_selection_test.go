@@ -0,0 +1,40 @@
+package run
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandSelection(t *testing.T) {
+	datasets := []string{"bronze_customers", "bronze_orders", "gold_revenue", "silver_orders"}
+
+	out, err := expandSelection([]string{"bronze_*", "gold_revenue"}, datasets)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bronze_customers", "bronze_orders", "gold_revenue"}, out)
+
+	// Datasets matched by several patterns are included once.
+	out, err = expandSelection([]string{"*_orders", "bronze_*"}, datasets)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bronze_orders", "silver_orders", "bronze_customers"}, out)
+
+	// Names that are not patterns are passed through as is.
+	out, err = expandSelection([]string{"unknown_table"}, datasets)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"unknown_table"}, out)
+
+	out, err = expandSelection(nil, datasets)
+	require.NoError(t, err)
+	assert.Nil(t, out)
+}
+
+func TestExpandSelectionErrors(t *testing.T) {
+	datasets := []string{"bronze_orders", "silver_orders"}
+
+	_, err := expandSelection([]string{"gold_*"}, datasets)
+	assert.EqualError(t, err, `table pattern "gold_*" does not match any dataset; available datasets: bronze_orders, silver_orders`)
+
+	_, err = expandSelection([]string{"bronze_[*"}, datasets)
+	assert.ErrorContains(t, err, `invalid table pattern "bronze_[*"`)
+}
@@ -0,0 +1,133 @@
+package run
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/internal/bundletest"
+	"github.com/databricks/databricks-sdk-go/qa"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPipelineRunner(t *testing.T, fixtures qa.HTTPFixtures) *pipelineRunner {
+	pipeline := &resources.Pipeline{
+		ID:           "my-pipeline-id",
+		PipelineSpec: &pipelines.PipelineSpec{},
+	}
+
+	b := bundletest.WithFixtures(t, fixtures, config.Resources{
+		Pipelines: map[string]*resources.Pipeline{
+			"my_pipeline": pipeline,
+		},
+	})
+
+	return &pipelineRunner{key: "pipelines.my_pipeline", bundle: b, pipeline: pipeline}
+}
+
+func TestPipelineOptionsValidateOnlyExclusive(t *testing.T) {
+	opts := &PipelineOptions{ValidateOnly: true, Refresh: []string{"a"}}
+	assert.EqualError(t, opts.Validate(), "pipeline run arguments are mutually exclusive (got --refresh, --validate-only)")
+}
+
+func TestPipelineRunnerStartUpdateValidateOnly(t *testing.T) {
+	r := testPipelineRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/pipelines/my-pipeline-id/updates",
+			ExpectedRequest: map[string]any{
+				"validate_only": true,
+			},
+			Response: pipelines.StartUpdateResponse{UpdateId: "my-update-id"},
+		},
+	})
+
+	updateID, err := r.startUpdate(context.Background(), &PipelineOptions{ValidateOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, "my-update-id", updateID)
+}
+
+func TestPipelineOptionsResolvePatterns(t *testing.T) {
+	r := testPipelineRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/my-pipeline-id/events?filter=event_type%3D%27flow_definition%27&max_results=100",
+			Response: pipelines.ListPipelineEventsResponse{
+				Events: []pipelines.PipelineEvent{
+					{EventType: "flow_definition", Origin: &pipelines.Origin{UpdateId: "u1", DatasetName: "silver_orders"}},
+					{EventType: "flow_definition", Origin: &pipelines.Origin{UpdateId: "u1", DatasetName: "bronze_orders"}},
+					{EventType: "flow_definition", Origin: &pipelines.Origin{UpdateId: "u0", DatasetName: "gold_orders"}},
+				},
+				NextPageToken: "next",
+			},
+		},
+	})
+
+	opts := &PipelineOptions{Refresh: []string{"*_orders"}}
+	err := opts.resolvePatterns(context.Background(), r.bundle.WorkspaceClient(), &pipelines.GetPipelineResponse{
+		PipelineId: "my-pipeline-id",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bronze_orders", "silver_orders"}, opts.Refresh)
+}
+
+func TestListDatasetsAcrossPages(t *testing.T) {
+	r := testPipelineRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/my-pipeline-id/events?filter=event_type%3D%27flow_definition%27&max_results=100",
+			Response: pipelines.ListPipelineEventsResponse{
+				Events: []pipelines.PipelineEvent{
+					{EventType: "flow_definition", Origin: &pipelines.Origin{UpdateId: "u1", DatasetName: "silver_orders"}},
+					{EventType: "flow_definition", Origin: &pipelines.Origin{UpdateId: "u1", DatasetName: "bronze_orders"}},
+				},
+				NextPageToken: "page-2",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/my-pipeline-id/events?max_results=100&page_token=page-2",
+			Response: pipelines.ListPipelineEventsResponse{
+				Events: []pipelines.PipelineEvent{
+					{EventType: "flow_definition", Origin: &pipelines.Origin{UpdateId: "u1", DatasetName: "bronze_customers"}},
+					{EventType: "flow_definition", Origin: &pipelines.Origin{UpdateId: "u1", DatasetName: "silver_orders"}},
+				},
+				NextPageToken: "page-3",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/my-pipeline-id/events?max_results=100&page_token=page-3",
+			Response: pipelines.ListPipelineEventsResponse{
+				Events: []pipelines.PipelineEvent{
+					{EventType: "flow_definition", Origin: &pipelines.Origin{UpdateId: "u0", DatasetName: "gold_orders"}},
+				},
+				NextPageToken: "page-4",
+			},
+		},
+	})
+
+	datasets, err := listDatasets(context.Background(), r.bundle.WorkspaceClient(), &pipelines.GetPipelineResponse{
+		PipelineId: "my-pipeline-id",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bronze_customers", "bronze_orders", "silver_orders"}, datasets)
+}
+
+func TestListDatasetsWithoutDatasets(t *testing.T) {
+	r := testPipelineRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/my-pipeline-id/events?filter=event_type%3D%27flow_definition%27&max_results=100",
+			Response: pipelines.ListPipelineEventsResponse{},
+		},
+	})
+
+	_, err := listDatasets(context.Background(), r.bundle.WorkspaceClient(), &pipelines.GetPipelineResponse{
+		PipelineId: "my-pipeline-id",
+	})
+	assert.EqualError(t, err, "cannot resolve table patterns; the pipeline has no updates that define datasets")
+}
@@ -1,6 +1,11 @@
 package progress
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+)
 
 type PipelineUpdateUrlEvent struct {
 	Type       string `json:"type"`
@@ -25,3 +30,59 @@ func (event *PipelineUpdateUrlEvent) String() string {
 func (event *PipelineUpdateUrlEvent) IsInplaceSupported() bool {
 	return false
 }
+
+// PipelineErrorEvent is an error reported by a pipeline update,
+// along with the dataset and flow it originates from, if any.
+type PipelineErrorEvent struct {
+	Type       string   `json:"type"`
+	Timestamp  string   `json:"timestamp"`
+	EventType  string   `json:"event_type"`
+	Dataset    string   `json:"dataset,omitempty"`
+	Flow       string   `json:"flow,omitempty"`
+	Message    string   `json:"message"`
+	Exceptions []string `json:"exceptions,omitempty"`
+}
+
+func NewPipelineErrorEvent(event pipelines.PipelineEvent) *PipelineErrorEvent {
+	result := &PipelineErrorEvent{
+		Type:      "pipeline_error",
+		Timestamp: event.Timestamp,
+		EventType: event.EventType,
+		Message:   event.Message,
+	}
+	if event.Origin != nil {
+		result.Dataset = event.Origin.DatasetName
+		result.Flow = event.Origin.FlowName
+	}
+	if event.Error != nil {
+		for _, exception := range event.Error.Exceptions {
+			result.Exceptions = append(result.Exceptions, exception.Message)
+		}
+	}
+	return result
+}
+
+func (event *PipelineErrorEvent) String() string {
+	result := strings.Builder{}
+	switch {
+	case event.Dataset != "":
+		result.WriteString(fmt.Sprintf("Error in dataset %s", event.Dataset))
+	case event.Flow != "":
+		result.WriteString(fmt.Sprintf("Error in flow %s", event.Flow))
+	default:
+		result.WriteString("Error")
+	}
+	result.WriteString(fmt.Sprintf(" [%s]: %s\n", event.EventType, event.Message))
+
+	// Indent the exceptions, which may span multiple lines, under the message.
+	for _, exception := range event.Exceptions {
+		for _, line := range strings.Split(strings.TrimRight(exception, "\n"), "\n") {
+			result.WriteString("  " + line + "\n")
+		}
+	}
+	return result.String()
+}
+
+func (event *PipelineErrorEvent) IsInplaceSupported() bool {
+	return false
+}
@@ -76,3 +76,27 @@ func TestUpdateErrorIgnoredForWarnEvents(t *testing.T) {
 	}
 	assert.Equal(t, "2023-03-27T23:30:36.122Z update_progress WARN \"failed to update pipeline\"", event.String())
 }
+
+func TestPipelineErrorEventToString(t *testing.T) {
+	event := NewPipelineErrorEvent(pipelines.PipelineEvent{
+		EventType: "flow_progress",
+		Message:   "Failed to resolve flow",
+		Level:     pipelines.EventLevelError,
+		Origin: &pipelines.Origin{
+			DatasetName: "bronze_orders",
+			FlowName:    "bronze_orders",
+		},
+		Error: &pipelines.ErrorDetail{
+			Exceptions: []pipelines.SerializedException{
+				{Message: "Table or view not found: raw_orders\nline 1, pos 14"},
+			},
+		},
+	})
+	assert.Equal(t, "Error in dataset bronze_orders [flow_progress]: Failed to resolve flow\n  Table or view not found: raw_orders\n  line 1, pos 14\n", event.String())
+
+	event = NewPipelineErrorEvent(pipelines.PipelineEvent{
+		EventType: "update_progress",
+		Message:   "Update failed",
+	})
+	assert.Equal(t, "Error [update_progress]: Update failed\n", event.String())
+}
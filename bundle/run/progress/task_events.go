@@ -0,0 +1,134 @@
+package progress
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+type TaskStateEvent struct {
+	Type      string        `json:"type"`
+	Timestamp time.Time     `json:"timestamp"`
+	TaskKey   string        `json:"task_key"`
+	RunId     int64         `json:"run_id"`
+	State     jobs.RunState `json:"state"`
+}
+
+func NewTaskStateEvent(taskKey string, runId int64, state jobs.RunState) *TaskStateEvent {
+	return &TaskStateEvent{
+		Type:      "task_state",
+		Timestamp: time.Now(),
+		TaskKey:   taskKey,
+		RunId:     runId,
+		State:     state,
+	}
+}
+
+func (event *TaskStateEvent) String() string {
+	result := strings.Builder{}
+	result.WriteString(event.Timestamp.Format("2006-01-02 15:04:05") + " ")
+	result.WriteString(fmt.Sprintf("Task %s ", event.TaskKey))
+	result.WriteString(event.State.LifeCycleState.String())
+	if event.State.ResultState.String() != "" {
+		result.WriteString(" " + event.State.ResultState.String())
+	}
+	if event.State.StateMessage != "" {
+		result.WriteString(" " + event.State.StateMessage)
+	}
+	return result.String()
+}
+
+func (event *TaskStateEvent) IsInplaceSupported() bool {
+	return false
+}
+
+// TaskOutputEvent holds output of a task that was not logged before.
+type TaskOutputEvent struct {
+	Type    string `json:"type"`
+	TaskKey string `json:"task_key"`
+	Output  string `json:"output"`
+}
+
+func NewTaskOutputEvent(taskKey string, output string) *TaskOutputEvent {
+	return &TaskOutputEvent{
+		Type:    "task_output",
+		TaskKey: taskKey,
+		Output:  output,
+	}
+}
+
+// String prefixes every line of the output with the task key,
+// so that the output of tasks that run concurrently can be told apart.
+func (event *TaskOutputEvent) String() string {
+	lines := strings.Split(strings.TrimSuffix(event.Output, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = fmt.Sprintf("[%s] %s", event.TaskKey, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (event *TaskOutputEvent) IsInplaceSupported() bool {
+	return false
+}
+
+type TaskSummary struct {
+	TaskKey        string                 `json:"task_key"`
+	LifeCycleState jobs.RunLifeCycleState `json:"life_cycle_state"`
+	ResultState    jobs.RunResultState    `json:"result_state,omitempty"`
+	Duration       time.Duration          `json:"duration"`
+}
+
+// JobRunSummaryEvent summarizes the state of the tasks of a run after it completes.
+type JobRunSummaryEvent struct {
+	Type  string        `json:"type"`
+	RunId int64         `json:"run_id"`
+	Tasks []TaskSummary `json:"tasks"`
+}
+
+func NewJobRunSummaryEvent(run *jobs.Run) *JobRunSummaryEvent {
+	event := &JobRunSummaryEvent{
+		Type:  "job_run_summary",
+		RunId: run.RunId,
+		Tasks: []TaskSummary{},
+	}
+	for _, task := range run.Tasks {
+		summary := TaskSummary{
+			TaskKey: task.TaskKey,
+		}
+		if task.State != nil {
+			summary.LifeCycleState = task.State.LifeCycleState
+			summary.ResultState = task.State.ResultState
+		}
+		if task.StartTime > 0 && task.EndTime >= task.StartTime {
+			summary.Duration = time.Duration(task.EndTime-task.StartTime) * time.Millisecond
+		}
+		event.Tasks = append(event.Tasks, summary)
+	}
+	return event
+}
+
+func (event *JobRunSummaryEvent) String() string {
+	result := strings.Builder{}
+	tw := tabwriter.NewWriter(&result, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "Task\tState\tResult\tDuration")
+	for _, task := range event.Tasks {
+		result := task.ResultState.String()
+		if result == "" {
+			result = "-"
+		}
+		duration := "-"
+		if task.Duration > 0 {
+			duration = task.Duration.Round(time.Second).String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", task.TaskKey, task.LifeCycleState, result, duration)
+	}
+	tw.Flush()
+	return strings.TrimSuffix(result.String(), "\n")
+}
+
+func (event *JobRunSummaryEvent) IsInplaceSupported() bool {
+	return false
+}
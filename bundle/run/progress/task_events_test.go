@@ -0,0 +1,55 @@
+package progress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskStateEventString(t *testing.T) {
+	event := &TaskStateEvent{
+		Timestamp: time.Date(0, 0, 0, 0, 0, 0, 0, &time.Location{}),
+		TaskKey:   "task_a",
+		RunId:     456,
+		State: jobs.RunState{
+			LifeCycleState: jobs.RunLifeCycleStateTerminated,
+			ResultState:    jobs.RunResultStateFailed,
+			StateMessage:   "state_message",
+		},
+	}
+	assert.Equal(t, "-0001-11-30 00:00:00 Task task_a TERMINATED FAILED state_message", event.String())
+}
+
+func TestTaskOutputEventString(t *testing.T) {
+	event := NewTaskOutputEvent("task_a", "hello\nworld\n")
+	assert.Equal(t, "[task_a] hello\n[task_a] world", event.String())
+}
+
+func TestJobRunSummaryEventString(t *testing.T) {
+	event := NewJobRunSummaryEvent(&jobs.Run{
+		RunId: 123,
+		Tasks: []jobs.RunTask{
+			{
+				TaskKey:   "task_a",
+				StartTime: 1000,
+				EndTime:   62000,
+				State: &jobs.RunState{
+					LifeCycleState: jobs.RunLifeCycleStateTerminated,
+					ResultState:    jobs.RunResultStateSuccess,
+				},
+			},
+			{
+				TaskKey: "task_b",
+				State: &jobs.RunState{
+					LifeCycleState: jobs.RunLifeCycleStateSkipped,
+				},
+			},
+		},
+	})
+	assert.Equal(t, ""+
+		"Task    State       Result   Duration\n"+
+		"task_a  TERMINATED  SUCCESS  1m1s\n"+
+		"task_b  SKIPPED     -        -", event.String())
+}
@@ -26,6 +26,12 @@ type Runner interface {
 
 	// Run the underlying worklow.
 	Run(ctx context.Context, opts *Options) (output.RunOutput, error)
+
+	// Cancel cancels active runs of the underlying workflow.
+	Cancel(ctx context.Context) error
+
+	// ParseArgs maps positional arguments (specified after `--`) to run options.
+	ParseArgs(args []string, opts *Options) error
 }
 
 // Find locates a runner matching the specified argument.
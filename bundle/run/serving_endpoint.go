@@ -0,0 +1,155 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/deploy/health"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/client"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+	flag "github.com/spf13/pflag"
+)
+
+// ServingEndpointOptions defines options for querying a model serving endpoint.
+type ServingEndpointOptions struct {
+	// Path to a file with the JSON payload to send to the endpoint, or "-" to read it from stdin.
+	Payload string
+
+	// Reader to read the payload from if it is read from stdin. Defaults to [os.Stdin].
+	in io.Reader
+}
+
+func (o *ServingEndpointOptions) Define(fs *flag.FlagSet) {
+	fs.StringVar(&o.Payload, "payload", "", `Path to a file with the JSON payload to send to a model serving endpoint, or "-" to read it from stdin.`)
+}
+
+// readPayload returns the payload to send to the endpoint, or nil if no payload is specified.
+func (o *ServingEndpointOptions) readPayload() ([]byte, error) {
+	if o.Payload == "" {
+		return nil, nil
+	}
+
+	var buf []byte
+	var err error
+	if o.Payload == "-" {
+		in := o.in
+		if in == nil {
+			in = os.Stdin
+		}
+		buf, err = io.ReadAll(in)
+	} else {
+		buf, err = os.ReadFile(o.Payload)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read payload: %w", err)
+	}
+
+	if !json.Valid(buf) {
+		return nil, fmt.Errorf("payload is not valid JSON")
+	}
+	return buf, nil
+}
+
+// Default timeout for waiting for a serving endpoint to become ready.
+var servingEndpointReadyTimeout time.Duration = 30 * time.Minute
+
+type servingEndpointRunner struct {
+	key
+
+	bundle   *bundle.Bundle
+	endpoint *resources.ModelServingEndpoint
+}
+
+func (r *servingEndpointRunner) Name() string {
+	if r.endpoint == nil || r.endpoint.CreateServingEndpoint == nil {
+		return ""
+	}
+	return r.endpoint.CreateServingEndpoint.Name
+}
+
+func (r *servingEndpointRunner) ParseArgs(args []string, opts *Options) error {
+	if len(args) == 0 {
+		return nil
+	}
+	return fmt.Errorf("received %d unexpected positional arguments", len(args))
+}
+
+// Cancel is not supported; requests to an endpoint are not tracked.
+func (r *servingEndpointRunner) Cancel(ctx context.Context) error {
+	return fmt.Errorf("--restart is not supported for model serving endpoints")
+}
+
+// waitForReady waits until the endpoint is not updating and all of its served models are ready.
+func (r *servingEndpointRunner) waitForReady(ctx context.Context, name string) error {
+	w := r.bundle.WorkspaceClient()
+	return health.WaitForServingEndpoint(ctx, w, name, servingEndpointReadyTimeout, func(state *serving.EndpointState) {
+		cmdio.LogString(ctx, fmt.Sprintf("Endpoint %s is %s (config update: %s)", name, state.Ready, state.ConfigUpdate))
+	})
+}
+
+// query sends the payload to the invocations URL of the endpoint and returns the response.
+func (r *servingEndpointRunner) query(ctx context.Context, name string, payload []byte) ([]byte, error) {
+	apiClient, err := client.New(r.bundle.WorkspaceClient().Config)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	path := fmt.Sprintf("/serving-endpoints/%s/invocations", url.PathEscape(name))
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	err = apiClient.Do(ctx, http.MethodPost, path, headers, bytes.NewReader(payload), &buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query endpoint %s: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *servingEndpointRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	// The ID of a serving endpoint is its name.
+	name := r.endpoint.ID
+	if name == "" {
+		return nil, fmt.Errorf("serving endpoint %s is not deployed", r.Key())
+	}
+
+	// Read the payload before waiting, so that problems with it are reported right away.
+	payload, err := opts.ServingEndpoint.readPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	// Include resource key in logger.
+	ctx = log.NewContext(ctx, log.GetLogger(ctx).With("resource", r.Key()))
+
+	if !opts.NoWait {
+		err = r.waitForReady(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if payload == nil {
+		cmdio.LogString(ctx, fmt.Sprintf("Endpoint %s is ready; specify --payload to send a request", name))
+		return nil, nil
+	}
+
+	start := time.Now()
+	response, err := r.query(ctx, name, payload)
+	if err != nil {
+		return nil, err
+	}
+	return output.NewServingEndpointOutput(response, time.Since(start)), nil
+}
@@ -0,0 +1,131 @@
+package run
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/internal/bundletest"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/databricks-sdk-go/qa"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testServingEndpointRunner(t *testing.T, fixtures qa.HTTPFixtures) *servingEndpointRunner {
+	endpoint := &resources.ModelServingEndpoint{
+		ID: "my-endpoint",
+		CreateServingEndpoint: &serving.CreateServingEndpoint{
+			Name: "my-endpoint",
+		},
+	}
+
+	b := bundletest.WithFixtures(t, fixtures, config.Resources{
+		ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+			"my_endpoint": endpoint,
+		},
+	})
+
+	return &servingEndpointRunner{key: "model_serving_endpoints.my_endpoint", bundle: b, endpoint: endpoint}
+}
+
+func TestServingEndpointOptionsReadPayload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "payload.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"inputs": [1, 2]}`), 0644))
+
+	opts := &ServingEndpointOptions{Payload: path}
+	payload, err := opts.readPayload()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"inputs": [1, 2]}`, string(payload))
+
+	opts = &ServingEndpointOptions{Payload: "-", in: strings.NewReader(`{"instances": []}`)}
+	payload, err = opts.readPayload()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"instances": []}`, string(payload))
+
+	opts = &ServingEndpointOptions{Payload: "-", in: strings.NewReader(`{`)}
+	_, err = opts.readPayload()
+	assert.EqualError(t, err, "payload is not valid JSON")
+
+	opts = &ServingEndpointOptions{}
+	payload, err = opts.readPayload()
+	require.NoError(t, err)
+	assert.Nil(t, payload)
+}
+
+func TestServingEndpointRunnerRun(t *testing.T) {
+	r := testServingEndpointRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/serving-endpoints/my-endpoint?",
+			Response: serving.ServingEndpointDetailed{
+				Name: "my-endpoint",
+				State: &serving.EndpointState{
+					Ready:        serving.EndpointStateReadyReady,
+					ConfigUpdate: serving.EndpointStateConfigUpdateNotUpdating,
+				},
+			},
+		},
+		{
+			Method:          "POST",
+			Resource:        "/serving-endpoints/my-endpoint/invocations",
+			ExpectedRequest: map[string]any{"inputs": []int{1, 2}},
+			Response:        map[string]any{"predictions": []int{3}},
+		},
+	})
+
+	opts := &Options{
+		ServingEndpoint: ServingEndpointOptions{
+			Payload: "-",
+			in:      strings.NewReader(`{"inputs": [1, 2]}`),
+		},
+	}
+	out, err := r.Run(context.Background(), opts)
+	require.NoError(t, err)
+
+	endpointOutput, ok := out.(*output.ServingEndpointOutput)
+	require.True(t, ok)
+	assert.JSONEq(t, `{"predictions": [3]}`, string(endpointOutput.Response))
+}
+
+func TestServingEndpointRunnerRunUpdateFailed(t *testing.T) {
+	r := testServingEndpointRunner(t, qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/serving-endpoints/my-endpoint?",
+			Response: serving.ServingEndpointDetailed{
+				Name: "my-endpoint",
+				State: &serving.EndpointState{
+					Ready:        serving.EndpointStateReadyNotReady,
+					ConfigUpdate: serving.EndpointStateConfigUpdateUpdateFailed,
+				},
+			},
+		},
+	})
+
+	_, err := r.Run(context.Background(), &Options{})
+	assert.ErrorContains(t, err, "failed to update the configuration of endpoint my-endpoint")
+}
+
+func TestServingEndpointRunnerRunNotDeployed(t *testing.T) {
+	r := &servingEndpointRunner{
+		key:      "model_serving_endpoints.my_endpoint",
+		endpoint: &resources.ModelServingEndpoint{},
+	}
+	_, err := r.Run(context.Background(), &Options{})
+	assert.EqualError(t, err, "serving endpoint model_serving_endpoints.my_endpoint is not deployed")
+}
+
+func TestServingEndpointRunnerCancel(t *testing.T) {
+	r := &servingEndpointRunner{
+		key:      "model_serving_endpoints.my_endpoint",
+		endpoint: &resources.ModelServingEndpoint{},
+	}
+	err := r.Cancel(context.Background())
+	assert.EqualError(t, err, "--restart is not supported for model serving endpoints")
+}
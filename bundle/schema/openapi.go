@@ -236,6 +236,84 @@ func (reader *OpenapiReader) registeredModelDocs() (*Docs, error) {
 	return registeredModelsAllDocs, nil
 }
 
+func (reader *OpenapiReader) schemasDocs() (*Docs, error) {
+	schemasSpecSchema, err := reader.readResolvedSchema(SchemaPathPrefix + "catalog.CreateSchema")
+	if err != nil {
+		return nil, err
+	}
+	schemasDocs := schemaToDocs(schemasSpecSchema)
+	schemasAllDocs := &Docs{
+		Description:          "List of Unity Catalog schemas",
+		AdditionalProperties: schemasDocs,
+	}
+	return schemasAllDocs, nil
+}
+
+func (reader *OpenapiReader) volumesDocs() (*Docs, error) {
+	volumesSpecSchema, err := reader.readResolvedSchema(SchemaPathPrefix + "catalog.CreateVolumeRequestContent")
+	if err != nil {
+		return nil, err
+	}
+	volumesDocs := schemaToDocs(volumesSpecSchema)
+	volumesAllDocs := &Docs{
+		Description:          "List of Unity Catalog volumes",
+		AdditionalProperties: volumesDocs,
+	}
+	return volumesAllDocs, nil
+}
+
+func (reader *OpenapiReader) sqlWarehousesDocs() (*Docs, error) {
+	sqlWarehousesSpecSchema, err := reader.readResolvedSchema(SchemaPathPrefix + "sql.CreateWarehouseRequest")
+	if err != nil {
+		return nil, err
+	}
+	sqlWarehousesDocs := schemaToDocs(sqlWarehousesSpecSchema)
+	sqlWarehousesAllDocs := &Docs{
+		Description:          "List of SQL warehouses",
+		AdditionalProperties: sqlWarehousesDocs,
+	}
+	return sqlWarehousesAllDocs, nil
+}
+
+func (reader *OpenapiReader) queriesDocs() (*Docs, error) {
+	queriesSpecSchema, err := reader.readResolvedSchema(SchemaPathPrefix + "sql.QueryPostContent")
+	if err != nil {
+		return nil, err
+	}
+	queriesDocs := schemaToDocs(queriesSpecSchema)
+	queriesAllDocs := &Docs{
+		Description:          "List of Databricks SQL queries",
+		AdditionalProperties: queriesDocs,
+	}
+	return queriesAllDocs, nil
+}
+
+func (reader *OpenapiReader) alertsDocs() (*Docs, error) {
+	alertsSpecSchema, err := reader.readResolvedSchema(SchemaPathPrefix + "sql.CreateAlert")
+	if err != nil {
+		return nil, err
+	}
+	alertsDocs := schemaToDocs(alertsSpecSchema)
+	alertsAllDocs := &Docs{
+		Description:          "List of Databricks SQL alerts",
+		AdditionalProperties: alertsDocs,
+	}
+	return alertsAllDocs, nil
+}
+
+func (reader *OpenapiReader) dashboardsDocs() (*Docs, error) {
+	dashboardsSpecSchema, err := reader.readResolvedSchema(SchemaPathPrefix + "sql.CreateDashboardRequest")
+	if err != nil {
+		return nil, err
+	}
+	dashboardsDocs := schemaToDocs(dashboardsSpecSchema)
+	dashboardsAllDocs := &Docs{
+		Description:          "List of Databricks SQL dashboards",
+		AdditionalProperties: dashboardsDocs,
+	}
+	return dashboardsAllDocs, nil
+}
+
 func (reader *OpenapiReader) ResourcesDocs() (*Docs, error) {
 	jobsDocs, err := reader.jobsDocs()
 	if err != nil {
@@ -261,6 +339,30 @@ func (reader *OpenapiReader) ResourcesDocs() (*Docs, error) {
 	if err != nil {
 		return nil, err
 	}
+	schemasDocs, err := reader.schemasDocs()
+	if err != nil {
+		return nil, err
+	}
+	volumesDocs, err := reader.volumesDocs()
+	if err != nil {
+		return nil, err
+	}
+	sqlWarehousesDocs, err := reader.sqlWarehousesDocs()
+	if err != nil {
+		return nil, err
+	}
+	queriesDocs, err := reader.queriesDocs()
+	if err != nil {
+		return nil, err
+	}
+	alertsDocs, err := reader.alertsDocs()
+	if err != nil {
+		return nil, err
+	}
+	dashboardsDocs, err := reader.dashboardsDocs()
+	if err != nil {
+		return nil, err
+	}
 
 	return &Docs{
 		Description: "Collection of Databricks resources to deploy.",
@@ -271,6 +373,12 @@ func (reader *OpenapiReader) ResourcesDocs() (*Docs, error) {
 			"models":                  modelsDocs,
 			"model_serving_endpoints": modelServingEndpointsDocs,
 			"registered_models":       registeredModelsDocs,
+			"schemas":                 schemasDocs,
+			"volumes":                 volumesDocs,
+			"sql_warehouses":          sqlWarehousesDocs,
+			"queries":                 queriesDocs,
+			"alerts":                  alertsDocs,
+			"dashboards":              dashboardsDocs,
 		},
 	}, nil
 }
@@ -0,0 +1,20 @@
+package schema
+
+import (
+	"reflect"
+
+	"github.com/databricks/cli/bundle/config"
+	dyn "github.com/databricks/cli/libs/config"
+	"github.com/databricks/cli/libs/diag"
+)
+
+// Validate validates a bundle configuration tree against the JSON schema
+// of the bundle configuration. This is the same schema that is emitted by
+// `databricks bundle schema`, without the documentation.
+func Validate(v dyn.Value) (diag.Diagnostics, error) {
+	s, err := New(reflect.TypeOf(config.Root{}), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.ValidateValue(v), nil
+}
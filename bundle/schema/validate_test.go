@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBundleConfiguration(t *testing.T) {
+	root := &config.Root{
+		Bundle: config.Bundle{
+			Name: "test",
+		},
+		Resources: config.Resources{
+			Jobs: map[string]*resources.Job{
+				"job": {
+					JobSettings: &jobs.JobSettings{
+						Name: "job",
+						Tasks: []jobs.Task{
+							{
+								TaskKey: "task",
+							},
+							{
+								NotebookTask: &jobs.NotebookTask{
+									NotebookPath: "./notebook.py",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	v, err := root.Value()
+	require.NoError(t, err)
+
+	diags, err := Validate(v)
+	require.NoError(t, err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "missing required field: resources.jobs.job.tasks[1].task_key", diags[0].Summary)
+}
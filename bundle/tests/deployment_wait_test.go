@@ -0,0 +1,23 @@
+package config_tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentWaitDevelopment(t *testing.T) {
+	b := loadTarget(t, "./deployment_wait", "development")
+	assert.False(t, b.Config.Bundle.Deployment.IsWaitEnabled())
+}
+
+func TestDeploymentWaitProduction(t *testing.T) {
+	b := loadTarget(t, "./deployment_wait", "production")
+	assert.True(t, b.Config.Bundle.Deployment.IsWaitEnabled())
+
+	timeout, err := b.Config.Bundle.Deployment.WaitTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, timeout)
+}
@@ -0,0 +1,3 @@
+SELECT sum(amount) AS total
+FROM sales
+WHERE date = current_date()
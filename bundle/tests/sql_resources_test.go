@@ -0,0 +1,64 @@
+package config_tests
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/interpolation"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqlResources(t *testing.T) {
+	b := loadTarget(t, "./sql_resources", "development")
+	require.Len(t, b.Config.Resources.SqlWarehouses, 1)
+	require.Len(t, b.Config.Resources.Queries, 1)
+	require.Len(t, b.Config.Resources.Alerts, 1)
+	require.Len(t, b.Config.Resources.Dashboards, 1)
+
+	q := b.Config.Resources.Queries["revenue"]
+	assert.Equal(t, "sql_resources/resources/revenue.yml", filepath.ToSlash(q.ConfigFilePath))
+	assert.Equal(t, "Daily revenue", q.Name)
+
+	a := b.Config.Resources.Alerts["revenue_dropped"]
+	assert.Equal(t, "total", a.Options.Column)
+	assert.Equal(t, "<", a.Options.Op)
+	assert.EqualValues(t, 1000, a.Options.Value)
+	assert.Equal(t, 0, a.Rearm)
+
+	d := b.Config.Resources.Dashboards["revenue_overview"]
+	assert.Equal(t, []string{"finance"}, d.Tags)
+}
+
+func TestSqlResourcesProduction(t *testing.T) {
+	b := loadTarget(t, "./sql_resources", "production")
+	assert.Equal(t, 3600, b.Config.Resources.Alerts["revenue_dropped"].Rearm)
+	assert.Equal(t, "Revenue dropped", b.Config.Resources.Alerts["revenue_dropped"].Name)
+}
+
+func TestSqlResourcesQueryFile(t *testing.T) {
+	b := loadTarget(t, "./sql_resources", "development")
+	b.Config.Workspace.FilePath = "/bundle"
+
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SetVariables(),
+		interpolation.Interpolate(
+			interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
+		),
+		mutator.TranslatePaths(),
+		terraform.Interpolate(),
+	))
+	require.NoError(t, err)
+
+	q := b.Config.Resources.Queries["revenue"]
+	assert.Equal(t, "/bundle/queries/revenue.sql", q.File)
+	assert.Contains(t, q.Query, "SELECT sum(amount) AS total")
+	assert.Equal(t, "${databricks_sql_endpoint.analytics.data_source_id}", q.DataSourceId)
+	assert.Equal(t, "${databricks_sql_query.revenue.id}", b.Config.Resources.Alerts["revenue_dropped"].QueryId)
+	assert.Equal(t, "2X-Small", b.Config.Resources.SqlWarehouses["analytics"].ClusterSize)
+}
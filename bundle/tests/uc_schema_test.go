@@ -0,0 +1,47 @@
+package config_tests
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUCSchemaAndVolume(t *testing.T) {
+	b := loadTarget(t, "./uc_schema", "development")
+	require.Len(t, b.Config.Resources.Schemas, 1)
+	require.Len(t, b.Config.Resources.Volumes, 1)
+
+	s := b.Config.Resources.Schemas["bronze"]
+	assert.Equal(t, "uc_schema/databricks.yml", filepath.ToSlash(s.ConfigFilePath))
+	assert.Equal(t, "main", s.CatalogName)
+	assert.Equal(t, "bronze", s.Name)
+	assert.Equal(t, "account users", s.Grants[0].Principal)
+	assert.Equal(t, []string{"USE_SCHEMA", "SELECT"}, s.Grants[0].Privileges)
+
+	v := b.Config.Resources.Volumes["landing"]
+	assert.Equal(t, "uc_schema/databricks.yml", filepath.ToSlash(v.ConfigFilePath))
+	assert.Equal(t, "main", v.CatalogName)
+	assert.Equal(t, catalog.VolumeTypeManaged, v.VolumeType)
+	assert.Equal(t, []string{"READ_VOLUME"}, v.Grants[0].Privileges)
+}
+
+func TestUCSchemaAndVolumeProduction(t *testing.T) {
+	b := loadTarget(t, "./uc_schema", "production")
+	assert.Equal(t, "prod", b.Config.Resources.Schemas["bronze"].CatalogName)
+	assert.Equal(t, "prod", b.Config.Resources.Volumes["landing"].CatalogName)
+}
+
+func TestUCSchemaReferences(t *testing.T) {
+	b := loadTarget(t, "./uc_schema", "development")
+	err := bundle.Apply(context.Background(), b, terraform.Interpolate())
+	require.NoError(t, err)
+
+	assert.Equal(t, "${databricks_schema.bronze.name}", b.Config.Resources.Volumes["landing"].SchemaName)
+	assert.Equal(t, "${databricks_schema.bronze.name}", b.Config.Resources.Pipelines["ingest"].Target)
+}
@@ -0,0 +1,36 @@
+package config_tests
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/schema"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUnknownField(t *testing.T) {
+	b := load(t, "./validate")
+
+	diags := b.Config.Diagnostics()
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "unknown field: max_concurent_runs (did you mean max_concurrent_runs?)", diags[0].Summary)
+	assert.Equal(t, "validate/databricks.yml", diags[0].Location.File)
+	assert.Equal(t, 8, diags[0].Location.Line)
+}
+
+func TestValidateMissingRequiredField(t *testing.T) {
+	b := load(t, "./validate")
+
+	v, err := b.Config.Value()
+	require.NoError(t, err)
+
+	diags, err := schema.Validate(v)
+	require.NoError(t, err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "missing required field: resources.jobs.my_job.tasks[1].task_key", diags[0].Summary)
+	assert.Equal(t, "validate/databricks.yml", diags[0].Location.File)
+	assert.Equal(t, 14, diags[0].Location.Line)
+}
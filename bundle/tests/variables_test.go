@@ -2,12 +2,14 @@ package config_tests
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config/interpolation"
 	"github.com/databricks/cli/bundle/config/mutator"
 	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/libs/redact"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -101,6 +103,111 @@ func TestVariablesWithoutDefinition(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, b.Config.Variables["a"].HasValue())
 	require.True(t, b.Config.Variables["b"].HasValue())
-	assert.Equal(t, "foo", *b.Config.Variables["a"].Value)
-	assert.Equal(t, "bar", *b.Config.Variables["b"].Value)
+	assert.Equal(t, "foo", b.Config.Variables["a"].Value)
+	assert.Equal(t, "bar", b.Config.Variables["b"].Value)
+}
+
+func TestVariablesComplex(t *testing.T) {
+	b := loadTarget(t, "./variables/complex", "default")
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SetVariables(),
+		interpolation.Interpolate(
+			interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
+		)))
+	require.NoError(t, err)
+
+	job := b.Config.Resources.Jobs["my_job"]
+	assert.Equal(t, "job-dev-3", job.Name)
+	assert.Equal(t, 3, job.MaxConcurrentRuns)
+	assert.Equal(t, 3, job.Tasks[0].MaxRetries)
+	assert.Equal(t, map[string]string{"team": "data", "env": "dev"}, job.Tags)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, job.EmailNotifications.OnFailure)
+
+	cluster := job.JobClusters[0].NewCluster
+	require.NotNil(t, cluster)
+	assert.Equal(t, "13.3.x-scala2.12", cluster.SparkVersion)
+	assert.Equal(t, "i3.xlarge", cluster.NodeTypeId)
+	assert.Equal(t, 2, cluster.NumWorkers)
+	assert.Equal(t, "2g", cluster.SparkConf["spark.executor.memory"])
+}
+
+func TestVariablesComplexTargetOverride(t *testing.T) {
+	b := loadTarget(t, "./variables/complex", "prod")
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SetVariables(),
+		interpolation.Interpolate(
+			interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
+		)))
+	require.NoError(t, err)
+
+	job := b.Config.Resources.Jobs["my_job"]
+	assert.Equal(t, "job-dev-5", job.Name)
+	assert.Equal(t, 5, job.MaxConcurrentRuns)
+
+	cluster := job.JobClusters[0].NewCluster
+	require.NotNil(t, cluster)
+	assert.Equal(t, "14.3.x-scala2.12", cluster.SparkVersion)
+	assert.Equal(t, "i3.2xlarge", cluster.NodeTypeId)
+	assert.Equal(t, 8, cluster.NumWorkers)
+	assert.Nil(t, cluster.SparkConf)
+}
+
+func TestVariablesComplexFromEnvironment(t *testing.T) {
+	t.Setenv("BUNDLE_VAR_emails", `["c@example.com"]`)
+	b := loadTarget(t, "./variables/complex", "default")
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SetVariables(),
+		interpolation.Interpolate(
+			interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
+		)))
+	require.NoError(t, err)
+
+	job := b.Config.Resources.Jobs["my_job"]
+	assert.Equal(t, []string{"c@example.com"}, job.EmailNotifications.OnFailure)
+}
+
+func TestVariablesOverridesFile(t *testing.T) {
+	b := loadTarget(t, "./variables/overrides_file", "dev")
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SetVariables(),
+		interpolation.Interpolate(
+			interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
+		)))
+	require.NoError(t, err)
+	assert.Equal(t, "file-a file-b", b.Config.Workspace.Profile)
+	assert.Equal(t, []any{"x", "y"}, b.Config.Variables["c"].Value)
+}
+
+func TestVariablesOverridesFileEnvTakesPrecedence(t *testing.T) {
+	t.Setenv("BUNDLE_VAR_b", "env-b")
+	b := loadTarget(t, "./variables/overrides_file", "dev")
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SetVariables(),
+		interpolation.Interpolate(
+			interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
+		)))
+	require.NoError(t, err)
+	assert.Equal(t, "file-a env-b", b.Config.Workspace.Profile)
+}
+
+func TestVariablesSensitiveAreRedacted(t *testing.T) {
+	t.Cleanup(redact.Reset)
+	t.Setenv("MY_SERVICE_TOKEN", "very-secret-token")
+	b := load(t, "./variables/sensitive")
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SetVariables(),
+		interpolation.Interpolate(
+			interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
+		)))
+	require.NoError(t, err)
+
+	task := b.Config.Resources.Jobs["my_job"].Tasks[0]
+	assert.Equal(t, []string{"--token=very-secret-token"}, task.SparkPythonTask.Parameters)
+
+	buf, err := json.Marshal(b.Config)
+	require.NoError(t, err)
+	out := string(redact.JSON(buf))
+	assert.NotContains(t, out, "very-secret-token")
+	assert.Contains(t, out, "--token=********")
+	assert.Contains(t, out, `"name":"hello"`)
 }
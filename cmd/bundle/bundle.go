@@ -13,13 +13,17 @@ func New() *cobra.Command {
 
 	initVariableFlag(cmd)
 	cmd.AddCommand(newDeployCommand())
+	cmd.AddCommand(newDeploymentCommand())
 	cmd.AddCommand(newDestroyCommand())
 	cmd.AddCommand(newLaunchCommand())
+	cmd.AddCommand(newPlanCommand())
 	cmd.AddCommand(newRunCommand())
 	cmd.AddCommand(newSchemaCommand())
+	cmd.AddCommand(newSummaryCommand())
 	cmd.AddCommand(newSyncCommand())
 	cmd.AddCommand(newTestCommand())
 	cmd.AddCommand(newValidateCommand())
 	cmd.AddCommand(newInitCommand())
+	cmd.AddCommand(newGenerateCommand())
 	return cmd
 }
@@ -15,9 +15,11 @@ func newDeployCommand() *cobra.Command {
 
 	var force bool
 	var forceLock bool
+	var autoApprove bool
 	var computeID string
 	cmd.Flags().BoolVar(&force, "force", false, "Force-override Git branch validation.")
 	cmd.Flags().BoolVar(&forceLock, "force-lock", false, "Force acquisition of deployment lock.")
+	cmd.Flags().BoolVar(&autoApprove, "auto-approve", false, "Skip interactive approvals for deleting or recreating resources.")
 	cmd.Flags().StringVarP(&computeID, "compute-id", "c", "", "Override compute in the deployment with the given compute ID.")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
@@ -27,6 +29,9 @@ func newDeployCommand() *cobra.Command {
 		b.Config.Bundle.Lock.Force = forceLock
 		b.Config.Bundle.ComputeID = computeID
 
+		// If `--auto-approve` is specified, we skip confirmation checks
+		b.AutoApprove = autoApprove
+
 		return bundle.Apply(cmd.Context(), b, bundle.Seq(
 			phases.Initialize(),
 			phases.Build(),
@@ -0,0 +1,21 @@
+package bundle
+
+import (
+	"github.com/databricks/cli/cmd/bundle/generate"
+	"github.com/spf13/cobra"
+)
+
+func newGenerateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate bundle configuration",
+		Long: `Generate bundle configuration for resources that already exist in the workspace.
+
+Include the generated configuration files in the bundle and run "bundle deploy"
+to take over management of the existing resources.`,
+	}
+
+	cmd.AddCommand(generate.NewGenerateJobCommand())
+	cmd.AddCommand(generate.NewGeneratePipelineCommand())
+	return cmd
+}
@@ -0,0 +1,220 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/config"
+	"github.com/databricks/cli/libs/config/yamlsaver"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+)
+
+// downloader downloads notebooks and files referenced by a resource
+// into the bundle and rewrites their paths to be relative to the
+// configuration file of the resource.
+type downloader struct {
+	w *databricks.WorkspaceClient
+
+	// Directory to download notebooks and files to.
+	sourceDir string
+
+	// Directory the configuration file is written to.
+	configDir string
+
+	// Maps local paths to the workspace paths to download.
+	files map[string]string
+}
+
+func newDownloader(w *databricks.WorkspaceClient, sourceDir string, configDir string) *downloader {
+	return &downloader{
+		w:         w,
+		sourceDir: sourceDir,
+		configDir: configDir,
+		files:     make(map[string]string),
+	}
+}
+
+func (d *downloader) MarkTaskForDownload(ctx context.Context, task *jobs.Task) error {
+	if task.NotebookTask != nil && task.NotebookTask.Source != jobs.SourceGit {
+		err := d.markNotebookForDownload(ctx, &task.NotebookTask.NotebookPath)
+		if err != nil {
+			return err
+		}
+	}
+	if task.SparkPythonTask != nil && task.SparkPythonTask.Source != jobs.SourceGit {
+		err := d.markFileForDownload(ctx, &task.SparkPythonTask.PythonFile)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *downloader) MarkPipelineLibraryForDownload(ctx context.Context, lib *pipelines.PipelineLibrary) error {
+	if lib.Notebook != nil {
+		err := d.markNotebookForDownload(ctx, &lib.Notebook.Path)
+		if err != nil {
+			return err
+		}
+	}
+	if lib.File != nil {
+		err := d.markFileForDownload(ctx, &lib.File.Path)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isWorkspacePath returns true if the path refers to an object in the workspace
+// file system. Paths with a scheme (e.g. `dbfs:/`) are not downloaded.
+func isWorkspacePath(p string) bool {
+	u, err := url.Parse(p)
+	if err != nil || u.Scheme != "" {
+		return false
+	}
+	return path.IsAbs(p)
+}
+
+func (d *downloader) markNotebookForDownload(ctx context.Context, notebookPath *string) error {
+	if !isWorkspacePath(*notebookPath) {
+		return nil
+	}
+
+	info, err := d.w.Workspace.GetStatusByPath(ctx, *notebookPath)
+	if err != nil {
+		return fmt.Errorf("failed to get status of %s: %w", *notebookPath, err)
+	}
+
+	ext := ""
+	switch info.Language {
+	case workspace.LanguagePython:
+		ext = ".py"
+	case workspace.LanguageR:
+		ext = ".r"
+	case workspace.LanguageScala:
+		ext = ".scala"
+	case workspace.LanguageSql:
+		ext = ".sql"
+	}
+
+	return d.mark(notebookPath, path.Base(*notebookPath)+ext)
+}
+
+func (d *downloader) markFileForDownload(ctx context.Context, filePath *string) error {
+	if !isWorkspacePath(*filePath) {
+		return nil
+	}
+
+	_, err := d.w.Workspace.GetStatusByPath(ctx, *filePath)
+	if err != nil {
+		return fmt.Errorf("failed to get status of %s: %w", *filePath, err)
+	}
+
+	return d.mark(filePath, path.Base(*filePath))
+}
+
+// mark records the workspace path for download to a file with the specified name
+// in the source directory, and rewrites the path to be relative to the configuration directory.
+func (d *downloader) mark(remotePath *string, name string) error {
+	localPath := filepath.Join(d.sourceDir, name)
+	if existing, ok := d.files[localPath]; ok && existing != *remotePath {
+		return fmt.Errorf("both %s and %s would be downloaded to %s", existing, *remotePath, localPath)
+	}
+	d.files[localPath] = *remotePath
+
+	rel, err := filepath.Rel(d.configDir, localPath)
+	if err != nil {
+		return err
+	}
+
+	*remotePath = filepath.ToSlash(rel)
+	return nil
+}
+
+// FlushToDisk downloads all marked notebooks and files.
+// It refuses to overwrite existing files unless force is set.
+func (d *downloader) FlushToDisk(ctx context.Context, force bool) error {
+	if !force {
+		for localPath := range d.files {
+			if _, err := os.Stat(localPath); err == nil {
+				return fmt.Errorf("%s already exists. Use --force to overwrite", localPath)
+			}
+		}
+	}
+
+	err := os.MkdirAll(d.sourceDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	for localPath, remotePath := range d.files {
+		err := d.download(ctx, remotePath, localPath)
+		if err != nil {
+			return err
+		}
+		cmdio.LogString(ctx, fmt.Sprintf("File successfully saved to %s", localPath))
+	}
+	return nil
+}
+
+func (d *downloader) download(ctx context.Context, remotePath, localPath string) error {
+	r, err := d.w.Workspace.Download(ctx, remotePath, workspace.DownloadFormat(workspace.ExportFormatSource))
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", remotePath, err)
+	}
+	defer r.Close()
+
+	f, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, r)
+	return err
+}
+
+// textToKey converts a resource name to a key that is valid in the configuration,
+// e.g. "My Job" becomes "my_job".
+func textToKey(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
+
+// saveResult downloads the marked files and writes the configuration to the specified file.
+// Existing files are checked up front, so that nothing is written if any of them exists.
+func saveResult(ctx context.Context, d *downloader, v config.Value, filename string, force bool) error {
+	if !force {
+		if _, err := os.Stat(filename); err == nil {
+			return fmt.Errorf("%s already exists. Use --force to overwrite", filename)
+		}
+	}
+
+	err := d.FlushToDisk(ctx, force)
+	if err != nil {
+		return err
+	}
+
+	// Write well-known keys first to make the configuration easier to read.
+	saver := yamlsaver.NewSaver("name", "task_key", "job_cluster_key")
+	return saver.SaveAsYAML(v, filename, force)
+}
@@ -0,0 +1,89 @@
+package generate
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/qa"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloaderMarkTaskForDownload(t *testing.T) {
+	cfg, server := qa.HTTPFixtures{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/workspace/get-status?path=%2FUsers%2Fme%2Fetl",
+			Response: workspace.ObjectInfo{
+				ObjectType: workspace.ObjectTypeNotebook,
+				Language:   workspace.LanguagePython,
+				Path:       "/Users/me/etl",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/workspace/get-status?path=%2FUsers%2Fme%2Fmain.py",
+			Response: workspace.ObjectInfo{
+				ObjectType: workspace.ObjectTypeFile,
+				Path:       "/Users/me/main.py",
+			},
+		},
+	}.Config(t)
+	defer server.Close()
+	w := databricks.Must(databricks.NewWorkspaceClient((*databricks.Config)(cfg)))
+
+	dir := t.TempDir()
+	d := newDownloader(w, filepath.Join(dir, "src"), filepath.Join(dir, "resources"))
+
+	tasks := []jobs.Task{
+		{
+			TaskKey:      "notebook",
+			NotebookTask: &jobs.NotebookTask{NotebookPath: "/Users/me/etl"},
+		},
+		{
+			TaskKey:         "python",
+			SparkPythonTask: &jobs.SparkPythonTask{PythonFile: "/Users/me/main.py"},
+		},
+		{
+			TaskKey:      "git",
+			NotebookTask: &jobs.NotebookTask{NotebookPath: "notebooks/etl", Source: jobs.SourceGit},
+		},
+		{
+			TaskKey:         "dbfs",
+			SparkPythonTask: &jobs.SparkPythonTask{PythonFile: "dbfs:/scripts/main.py"},
+		},
+	}
+
+	for i := range tasks {
+		require.NoError(t, d.MarkTaskForDownload(context.Background(), &tasks[i]))
+	}
+
+	assert.Equal(t, "../src/etl.py", tasks[0].NotebookTask.NotebookPath)
+	assert.Equal(t, "../src/main.py", tasks[1].SparkPythonTask.PythonFile)
+	assert.Equal(t, "notebooks/etl", tasks[2].NotebookTask.NotebookPath)
+	assert.Equal(t, "dbfs:/scripts/main.py", tasks[3].SparkPythonTask.PythonFile)
+	assert.Equal(t, map[string]string{
+		filepath.Join(dir, "src", "etl.py"):  "/Users/me/etl",
+		filepath.Join(dir, "src", "main.py"): "/Users/me/main.py",
+	}, d.files)
+}
+
+func TestDownloaderMarkDetectsCollisions(t *testing.T) {
+	d := newDownloader(nil, "src", "resources")
+
+	a := "/Users/me/a/main.py"
+	b := "/Users/me/b/main.py"
+	require.NoError(t, d.mark(&a, "main.py"))
+	err := d.mark(&b, "main.py")
+	assert.ErrorContains(t, err, "both /Users/me/a/main.py and /Users/me/b/main.py would be downloaded to")
+}
+
+func TestTextToKey(t *testing.T) {
+	assert.Equal(t, "my_job", textToKey("My Job"))
+	assert.Equal(t, "nightly_etl-v2", textToKey(" Nightly ETL-v2 "))
+	assert.Equal(t, "a_b_c", textToKey("a.b/c"))
+}
@@ -0,0 +1,94 @@
+package generate
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/generate"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/config"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/spf13/cobra"
+)
+
+func NewGenerateJobCommand() *cobra.Command {
+	var configDir string
+	var sourceDir string
+	var jobId int64
+	var key string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "job",
+		Short: "Generate bundle configuration for a job",
+		Long: `Generate bundle configuration for an existing job.
+
+The settings of the job are written to a YAML file in the configuration directory.
+Notebooks and files in the workspace that are referenced by the job are downloaded
+to the source directory, and their paths are rewritten to refer to the local copies.
+Paths of both directories are relative to the bundle root.`,
+		PreRunE: root.MustConfigureBundle,
+	}
+
+	cmd.Flags().Int64Var(&jobId, "existing-job-id", 0, `ID of the job to generate configuration for.`)
+	cmd.MarkFlagRequired("existing-job-id")
+	cmd.Flags().StringVar(&key, "key", "", `Resource key to use for the generated configuration. Defaults to the name of the job.`)
+	cmd.Flags().StringVarP(&configDir, "config-dir", "s", "resources", `Directory to write the configuration file to.`)
+	cmd.Flags().StringVarP(&sourceDir, "source-dir", "d", "src", `Directory to download notebooks and files to.`)
+	cmd.Flags().BoolVarP(&force, "force", "f", false, `Overwrite existing files.`)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+		w := b.WorkspaceClient()
+
+		job, err := w.Jobs.Get(ctx, jobs.GetJobRequest{JobId: jobId})
+		if err != nil {
+			return err
+		}
+		if job.Settings == nil {
+			return fmt.Errorf("job %d has no settings", jobId)
+		}
+
+		configDir = filepath.Join(b.Config.Path, configDir)
+		sourceDir = filepath.Join(b.Config.Path, sourceDir)
+
+		d := newDownloader(w, sourceDir, configDir)
+		for i := range job.Settings.Tasks {
+			err := d.MarkTaskForDownload(ctx, &job.Settings.Tasks[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		v, err := generate.ConvertJobToValue(job)
+		if err != nil {
+			return err
+		}
+
+		if key == "" {
+			key = textToKey(job.Settings.Name)
+		}
+
+		result := config.V(map[string]config.Value{
+			"resources": config.V(map[string]config.Value{
+				"jobs": config.V(map[string]config.Value{
+					key: v,
+				}),
+			}),
+		})
+
+		filename := filepath.Join(configDir, fmt.Sprintf("%s.yml", key))
+		err = saveResult(ctx, d, result, filename, force)
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Job configuration successfully saved to %s", filename))
+		return nil
+	}
+
+	return cmd
+}
@@ -0,0 +1,94 @@
+package generate
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/generate"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/config"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/spf13/cobra"
+)
+
+func NewGeneratePipelineCommand() *cobra.Command {
+	var configDir string
+	var sourceDir string
+	var pipelineId string
+	var key string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "pipeline",
+		Short: "Generate bundle configuration for a pipeline",
+		Long: `Generate bundle configuration for an existing Delta Live Tables pipeline.
+
+The specification of the pipeline is written to a YAML file in the configuration directory.
+Notebooks and files in the workspace that are referenced by the pipeline are downloaded
+to the source directory, and their paths are rewritten to refer to the local copies.
+Paths of both directories are relative to the bundle root.`,
+		PreRunE: root.MustConfigureBundle,
+	}
+
+	cmd.Flags().StringVar(&pipelineId, "existing-pipeline-id", "", `ID of the pipeline to generate configuration for.`)
+	cmd.MarkFlagRequired("existing-pipeline-id")
+	cmd.Flags().StringVar(&key, "key", "", `Resource key to use for the generated configuration. Defaults to the name of the pipeline.`)
+	cmd.Flags().StringVarP(&configDir, "config-dir", "s", "resources", `Directory to write the configuration file to.`)
+	cmd.Flags().StringVarP(&sourceDir, "source-dir", "d", "src", `Directory to download notebooks and files to.`)
+	cmd.Flags().BoolVarP(&force, "force", "f", false, `Overwrite existing files.`)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+		w := b.WorkspaceClient()
+
+		pipeline, err := w.Pipelines.Get(ctx, pipelines.GetPipelineRequest{PipelineId: pipelineId})
+		if err != nil {
+			return err
+		}
+		if pipeline.Spec == nil {
+			return fmt.Errorf("pipeline %s has no specification", pipelineId)
+		}
+
+		configDir = filepath.Join(b.Config.Path, configDir)
+		sourceDir = filepath.Join(b.Config.Path, sourceDir)
+
+		d := newDownloader(w, sourceDir, configDir)
+		for i := range pipeline.Spec.Libraries {
+			err := d.MarkPipelineLibraryForDownload(ctx, &pipeline.Spec.Libraries[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		v, err := generate.ConvertPipelineToValue(pipeline.Spec)
+		if err != nil {
+			return err
+		}
+
+		if key == "" {
+			key = textToKey(pipeline.Spec.Name)
+		}
+
+		result := config.V(map[string]config.Value{
+			"resources": config.V(map[string]config.Value{
+				"pipelines": config.V(map[string]config.Value{
+					key: v,
+				}),
+			}),
+		})
+
+		filename := filepath.Join(configDir, fmt.Sprintf("%s.yml", key))
+		err = saveResult(ctx, d, result, filename, force)
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Pipeline configuration successfully saved to %s", filename))
+		return nil
+	}
+
+	return cmd
+}
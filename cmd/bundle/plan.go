@@ -0,0 +1,77 @@
+package bundle
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/terraform"
+	"github.com/spf13/cobra"
+)
+
+type planSummary struct {
+	Create   int                        `json:"create"`
+	Update   int                        `json:"update"`
+	Recreate int                        `json:"recreate"`
+	Delete   int                        `json:"delete"`
+	Changes  []terraform.ResourceChange `json:"changes"`
+}
+
+func newPlanSummary(plan *terraform.Plan) *planSummary {
+	summary := &planSummary{
+		Changes: []terraform.ResourceChange{},
+	}
+	if plan == nil || plan.IsEmpty {
+		return summary
+	}
+	for _, c := range plan.Changes {
+		switch c.Action {
+		case terraform.ActionCreate:
+			summary.Create++
+		case terraform.ActionUpdate:
+			summary.Update++
+		case terraform.ActionRecreate:
+			summary.Recreate++
+		case terraform.ActionDelete:
+			summary.Delete++
+		}
+		summary.Changes = append(summary.Changes, c)
+	}
+	return summary
+}
+
+const planTemplate = `{{range .Changes}}{{if eq .Action "create"}}{{green "%-8s" .Action}}{{else if eq .Action "update"}}{{yellow "%-8s" .Action}}{{else}}{{red "%-8s" .Action}}{{end}} {{.Group}}.{{.Key}}
+{{range .Fields}}           ~ {{.}}
+{{end}}{{end}}Plan: {{.Create}} to create, {{.Update}} to update, {{.Recreate}} to recreate, {{.Delete}} to delete
+`
+
+func newPlanCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the changes a deployment would make",
+		Long: `Show the changes a deployment would make.
+
+Computes the difference between the bundle configuration and the deployed
+resources and lists the resources that would be created, updated, recreated
+or deleted by running "databricks bundle deploy", without applying them.`,
+
+		PreRunE: ConfigureBundleWithVariables,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+
+		err := bundle.Apply(ctx, b, bundle.Seq(
+			phases.Initialize(),
+			phases.Build(),
+			phases.Plan(),
+		))
+		if err != nil {
+			return err
+		}
+
+		return cmdio.RenderWithTemplate(ctx, newPlanSummary(b.Plan), planTemplate)
+	}
+
+	return cmd
+}
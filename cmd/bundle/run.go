@@ -16,10 +16,37 @@ import (
 
 func newRunCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "run [flags] KEY",
+		Use:   "run [flags] KEY [-- ARGS...]",
 		Short: "Run a resource (e.g. a job or a pipeline)",
+		Long: `Run a resource (e.g. a job or a pipeline).
 
-		Args:    cobra.MaximumNArgs(1),
+Arguments after "--" are passed to the run. If the job declares parameters, they
+are interpreted as job parameters of the form --key=value. Otherwise, they are
+passed as parameters to the tasks of the job, depending on their type: as
+key-value pairs of the form --key=value to notebook and SQL tasks, and as a list
+to Python, JAR, Spark submit and dbt tasks.
+
+The tables to update in a pipeline can be selected by name or by glob pattern,
+e.g. --refresh "bronze_*". Specify --validate-only to check the pipeline graph
+for errors without processing any data; the errors are reported per dataset.
+
+Running a model serving endpoint waits until the endpoint is ready and sends the
+JSON payload specified with --payload to it. The response and the latency of the
+request are printed.
+
+Pressing Ctrl-C while waiting for a run offers to cancel the run in the workspace.
+Otherwise, the run continues after the command exits.`,
+
+		Args: func(cmd *cobra.Command, args []string) error {
+			n := len(args)
+			if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+				n = dash
+			}
+			if n > 1 {
+				return fmt.Errorf("accepts at most 1 arg(s) before \"--\", received %d", n)
+			}
+			return nil
+		},
 		PreRunE: ConfigureBundleWithVariables,
 	}
 
@@ -27,7 +54,12 @@ func newRunCommand() *cobra.Command {
 	runOptions.Define(cmd.Flags())
 
 	var noWait bool
+	var follow bool
+	var restart bool
 	cmd.Flags().BoolVar(&noWait, "no-wait", false, "Don't wait for the run to complete.")
+	cmd.Flags().BoolVar(&follow, "follow", false, "Log state transitions and output of the tasks of a job while the run is active.")
+	cmd.Flags().BoolVar(&restart, "restart", false, "Cancel active runs of the job, or stop the pipeline update in progress, before starting a new one.")
+	cmd.MarkFlagsMutuallyExclusive("no-wait", "follow")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
@@ -44,6 +76,13 @@ func newRunCommand() *cobra.Command {
 			return err
 		}
 
+		// Split off the arguments to pass to the run.
+		var runArgs []string
+		if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+			runArgs = args[dash:]
+			args = args[:dash]
+		}
+
 		// If no arguments are specified, prompt the user to select something to run.
 		if len(args) == 0 && cmdio.IsInteractive(ctx) {
 			// Invert completions from KEY -> NAME, to NAME -> KEY.
@@ -67,7 +106,19 @@ func newRunCommand() *cobra.Command {
 			return err
 		}
 
+		err = runner.ParseArgs(runArgs, &runOptions)
+		if err != nil {
+			return err
+		}
+
 		runOptions.NoWait = noWait
+		runOptions.Follow = follow
+		if restart {
+			err := runner.Cancel(ctx)
+			if err != nil {
+				return err
+			}
+		}
 		output, err := runner.Run(ctx, &runOptions)
 		if err != nil {
 			return err
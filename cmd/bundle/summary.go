@@ -0,0 +1,274 @@
+package bundle
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/spf13/cobra"
+)
+
+type resourceSummary struct {
+	// Group of the resource in the bundle configuration (e.g. "jobs").
+	Group string `json:"group"`
+
+	// Key of the resource in the bundle configuration.
+	Key string `json:"key"`
+
+	Name string `json:"name,omitempty"`
+
+	// ID of the resource in the workspace. Empty if the resource has not been deployed.
+	ID string `json:"id,omitempty"`
+
+	// URL of the resource in the workspace. Empty if the resource has not been deployed.
+	URL string `json:"url,omitempty"`
+}
+
+type workspaceSummary struct {
+	Host         string `json:"host"`
+	User         string `json:"user,omitempty"`
+	RootPath     string `json:"root_path"`
+	FilePath     string `json:"file_path"`
+	ArtifactPath string `json:"artifact_path"`
+	StatePath    string `json:"state_path"`
+}
+
+type bundleSummary struct {
+	Name      string            `json:"name"`
+	Target    string            `json:"target"`
+	Workspace workspaceSummary  `json:"workspace"`
+	Resources []resourceSummary `json:"resources"`
+}
+
+// resourceURL returns the URL of a deployed resource in the workspace.
+// The IDs of models and serving endpoints are their names, and the IDs of
+// objects in Unity Catalog are their full names, which are separated by dots.
+func resourceURL(host string, group string, id string) string {
+	if id == "" {
+		return ""
+	}
+
+	var path string
+	switch group {
+	case "jobs":
+		path = "#job/" + id
+	case "pipelines":
+		path = "#joblist/pipelines/" + id
+	case "models":
+		path = "#mlflow/models/" + url.PathEscape(id)
+	case "experiments":
+		path = "#mlflow/experiments/" + id
+	case "model_serving_endpoints":
+		path = "ml/endpoints/" + url.PathEscape(id)
+	case "registered_models":
+		path = "explore/data/models/" + strings.ReplaceAll(id, ".", "/")
+	case "schemas":
+		path = "explore/data/" + strings.ReplaceAll(id, ".", "/")
+	case "volumes":
+		path = "explore/data/volumes/" + strings.ReplaceAll(id, ".", "/")
+	case "sql_warehouses":
+		path = "sql/warehouses/" + id
+	case "queries":
+		path = "sql/editor/" + id
+	case "alerts":
+		path = "sql/alerts/" + id
+	case "dashboards":
+		path = "sql/dashboards/" + id
+	default:
+		return ""
+	}
+
+	return strings.TrimSuffix(host, "/") + "/" + path
+}
+
+// appendResources appends a summary of every resource in the map, ordered by key.
+func appendResources[T any](out []resourceSummary, host string, group string, m map[string]*T, fn func(*T) (name string, id string)) []resourceSummary {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		var name, id string
+		if m[k] != nil {
+			name, id = fn(m[k])
+		}
+		out = append(out, resourceSummary{
+			Group: group,
+			Key:   k,
+			Name:  name,
+			ID:    id,
+			URL:   resourceURL(host, group, id),
+		})
+	}
+	return out
+}
+
+func summarizeResources(host string, r *config.Resources) []resourceSummary {
+	out := []resourceSummary{}
+	out = appendResources(out, host, "jobs", r.Jobs, func(v *resources.Job) (string, string) {
+		if v.JobSettings == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "pipelines", r.Pipelines, func(v *resources.Pipeline) (string, string) {
+		if v.PipelineSpec == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "models", r.Models, func(v *resources.MlflowModel) (string, string) {
+		if v.Model == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "experiments", r.Experiments, func(v *resources.MlflowExperiment) (string, string) {
+		if v.Experiment == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "model_serving_endpoints", r.ModelServingEndpoints, func(v *resources.ModelServingEndpoint) (string, string) {
+		if v.CreateServingEndpoint == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "registered_models", r.RegisteredModels, func(v *resources.RegisteredModel) (string, string) {
+		if v.CreateRegisteredModelRequest == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "schemas", r.Schemas, func(v *resources.Schema) (string, string) {
+		if v.CreateSchema == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "volumes", r.Volumes, func(v *resources.Volume) (string, string) {
+		if v.CreateVolumeRequestContent == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "sql_warehouses", r.SqlWarehouses, func(v *resources.SqlWarehouse) (string, string) {
+		if v.CreateWarehouseRequest == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "queries", r.Queries, func(v *resources.Query) (string, string) {
+		if v.QueryPostContent == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "alerts", r.Alerts, func(v *resources.Alert) (string, string) {
+		if v.CreateAlert == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	out = appendResources(out, host, "dashboards", r.Dashboards, func(v *resources.Dashboard) (string, string) {
+		if v.CreateDashboardRequest == nil {
+			return "", v.ID
+		}
+		return v.Name, v.ID
+	})
+	return out
+}
+
+func newBundleSummary(host string, root *config.Root) *bundleSummary {
+	summary := &bundleSummary{
+		Name:   root.Bundle.Name,
+		Target: root.Bundle.Target,
+		Workspace: workspaceSummary{
+			Host:         host,
+			RootPath:     root.Workspace.RootPath,
+			FilePath:     root.Workspace.FilePath,
+			ArtifactPath: root.Workspace.ArtifactPath,
+			StatePath:    root.Workspace.StatePath,
+		},
+		Resources: summarizeResources(host, &root.Resources),
+	}
+	if root.Workspace.CurrentUser != nil && root.Workspace.CurrentUser.User != nil {
+		summary.Workspace.User = root.Workspace.CurrentUser.UserName
+	}
+	return summary
+}
+
+const summaryTemplate = `Name: {{.Name}}
+Target: {{.Target}}
+Workspace:
+  Host:	{{.Workspace.Host}}
+{{- if .Workspace.User}}
+  User:	{{.Workspace.User}}
+{{- end}}
+  Path:	{{.Workspace.RootPath}}
+  Files:	{{.Workspace.FilePath}}
+  Artifacts:	{{.Workspace.ArtifactPath}}
+  State:	{{.Workspace.StatePath}}
+Resources:
+{{- range .Resources}}
+  {{.Group}}.{{.Key}}
+    Name:	{{.Name}}
+{{- if .ID}}
+    ID:	{{.ID}}
+    URL:	{{.URL | cyan}}
+{{- else}}
+    ID:	{{"(not deployed)" | yellow}}
+{{- end}}
+{{- end}}
+`
+
+func newSummaryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summary",
+		Short: "Describe the bundle resources and their deployment state",
+		Long: `Describe the bundle resources and their deployment state.
+
+Lists the resources defined in the bundle, along with their names, IDs and URLs
+in the workspace as recorded by the last deployment, and the workspace paths
+that the bundle deploys files, artifacts and state to.
+
+Specify "-o json" to get the summary as JSON.`,
+
+		PreRunE: ConfigureBundleWithVariables,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+
+		err := bundle.Apply(ctx, b, bundle.Seq(
+			phases.Initialize(),
+			terraform.Interpolate(),
+			terraform.Write(),
+			terraform.StatePull(),
+			terraform.Load(),
+		))
+		if err != nil {
+			return err
+		}
+
+		host := b.WorkspaceClient().Config.Host
+		if host == "" {
+			return fmt.Errorf("unable to determine the workspace host")
+		}
+
+		return cmdio.RenderWithTemplate(ctx, newBundleSummary(host, &b.Config), summaryTemplate)
+	}
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package bundle
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundleSummary(t *testing.T) {
+	root := &config.Root{
+		Bundle: config.Bundle{
+			Name:   "my_bundle",
+			Target: "dev",
+		},
+		Workspace: config.Workspace{
+			CurrentUser: &config.User{
+				User: &iam.User{UserName: "jane@doe.com"},
+			},
+			RootPath:     "/Users/jane@doe.com/.bundle/my_bundle/dev",
+			FilePath:     "/Users/jane@doe.com/.bundle/my_bundle/dev/files",
+			ArtifactPath: "/Users/jane@doe.com/.bundle/my_bundle/dev/artifacts",
+			StatePath:    "/Users/jane@doe.com/.bundle/my_bundle/dev/state",
+		},
+		Resources: config.Resources{
+			Jobs: map[string]*resources.Job{
+				"b_job": {
+					ID:          "123",
+					JobSettings: &jobs.JobSettings{Name: "Job B"},
+				},
+				"a_job": {
+					JobSettings: &jobs.JobSettings{Name: "Job A"},
+				},
+			},
+			Pipelines: map[string]*resources.Pipeline{
+				"pipeline": {
+					ID:           "abc-def",
+					PipelineSpec: &pipelines.PipelineSpec{Name: "Pipeline"},
+				},
+			},
+			Schemas: map[string]*resources.Schema{
+				"schema": {
+					ID:           "main.dev_jane_schema",
+					CreateSchema: &catalog.CreateSchema{Name: "dev_jane_schema", CatalogName: "main"},
+				},
+			},
+		},
+	}
+
+	summary := newBundleSummary("https://myworkspace.cloud.databricks.com/", root)
+	assert.Equal(t, "my_bundle", summary.Name)
+	assert.Equal(t, "dev", summary.Target)
+	assert.Equal(t, "jane@doe.com", summary.Workspace.User)
+	assert.Equal(t, "/Users/jane@doe.com/.bundle/my_bundle/dev/files", summary.Workspace.FilePath)
+	assert.Equal(t, []resourceSummary{
+		{Group: "jobs", Key: "a_job", Name: "Job A"},
+		{Group: "jobs", Key: "b_job", Name: "Job B", ID: "123", URL: "https://myworkspace.cloud.databricks.com/#job/123"},
+		{Group: "pipelines", Key: "pipeline", Name: "Pipeline", ID: "abc-def", URL: "https://myworkspace.cloud.databricks.com/#joblist/pipelines/abc-def"},
+		{Group: "schemas", Key: "schema", Name: "dev_jane_schema", ID: "main.dev_jane_schema", URL: "https://myworkspace.cloud.databricks.com/explore/data/main/dev_jane_schema"},
+	}, summary.Resources)
+}
+
+func TestBundleSummaryWithoutResources(t *testing.T) {
+	summary := newBundleSummary("https://myworkspace.cloud.databricks.com", &config.Root{})
+	assert.NotNil(t, summary.Resources)
+	assert.Empty(t, summary.Resources)
+}
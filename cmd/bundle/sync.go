@@ -1,11 +1,14 @@
 package bundle
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/flags"
 	"github.com/databricks/cli/libs/log"
 	"github.com/databricks/cli/libs/sync"
 	"github.com/spf13/cobra"
@@ -15,6 +18,8 @@ type syncFlags struct {
 	interval time.Duration
 	full     bool
 	watch    bool
+	hash     bool
+	dryRun   bool
 }
 
 func (f *syncFlags) syncOptionsFromBundle(cmd *cobra.Command, b *bundle.Bundle) (*sync.SyncOptions, error) {
@@ -34,6 +39,8 @@ func (f *syncFlags) syncOptionsFromBundle(cmd *cobra.Command, b *bundle.Bundle)
 		Include:      includes,
 		Exclude:      b.Config.Sync.Exclude,
 		Full:         f.full,
+		ContentHash:  f.hash,
+		DryRun:       f.dryRun,
 		PollInterval: f.interval,
 
 		SnapshotBasePath: cacheDir,
@@ -52,9 +59,12 @@ func newSyncCommand() *cobra.Command {
 	}
 
 	var f syncFlags
-	cmd.Flags().DurationVar(&f.interval, "interval", 1*time.Second, "file system polling interval (for --watch)")
+	cmd.Flags().DurationVar(&f.interval, "interval", 1*time.Second, "file system polling interval (for --watch, if file system events cannot be watched)")
 	cmd.Flags().BoolVar(&f.full, "full", false, "perform full synchronization (default is incremental)")
 	cmd.Flags().BoolVar(&f.watch, "watch", false, "watch local file system for changes")
+	cmd.Flags().BoolVar(&f.hash, "content-hash", false, "detect changes by comparing content hashes of files (default is modified times only)")
+	cmd.Flags().BoolVar(&f.dryRun, "dry-run", false, "print the changes that would be synchronized without applying them")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "watch")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		b := bundle.Get(cmd.Context())
@@ -78,6 +88,10 @@ func newSyncCommand() *cobra.Command {
 
 		log.Infof(ctx, "Remote file sync location: %v", opts.RemotePath)
 
+		if f.dryRun {
+			return dryRun(cmd, s)
+		}
+
 		if f.watch {
 			return s.RunContinuous(ctx)
 		}
@@ -87,3 +101,28 @@ func newSyncCommand() *cobra.Command {
 
 	return cmd
 }
+
+// dryRun prints the changes that a sync would apply, as text or as JSON
+// depending on the output flag.
+func dryRun(cmd *cobra.Command, s *sync.Sync) error {
+	events := s.Events()
+	err := s.RunOnce(cmd.Context())
+	s.Close()
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	for e := range events {
+		switch root.OutputType(cmd) {
+		case flags.OutputJSON:
+			err = json.NewEncoder(out).Encode(e)
+		default:
+			_, err = fmt.Fprintln(out, e.String())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
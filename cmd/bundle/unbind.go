@@ -0,0 +1,50 @@
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/spf13/cobra"
+)
+
+func newUnbindCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbind KEY",
+		Short: "Unbind a bundle-defined resource from its managed remote resource",
+		Long: `Unbind a resource defined in the bundle from the resource it manages in the workspace.
+
+The resource is removed from the deployment state but is not deleted from the
+workspace. The next deployment creates a new resource for it.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: ConfigureBundleWithVariables,
+	}
+
+	var forceLock bool
+	cmd.Flags().BoolVar(&forceLock, "force-lock", false, "Force acquisition of deployment lock.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+
+		resource, err := b.Config.Resources.FindResourceByConfigKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		b.Config.Bundle.Lock.Force = forceLock
+		err = bundle.Apply(ctx, b, bundle.Seq(
+			phases.Initialize(),
+			phases.Unbind(resource.TerraformResourceName(), args[0]),
+		))
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Successfully unbound %s with key '%s'", resource.TerraformResourceName(), args[0]))
+		return nil
+	}
+
+	return cmd
+}
@@ -2,33 +2,116 @@ package bundle
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/schema"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/redact"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
+func renderDiagnostics(w io.Writer, diags diag.Diagnostics) {
+	for _, d := range diags {
+		summary := redact.String(d.Summary)
+		switch d.Severity {
+		case diag.Error:
+			fmt.Fprintf(w, "%s: %s\n", color.RedString("Error"), summary)
+		case diag.Warning:
+			fmt.Fprintf(w, "%s: %s\n", color.YellowString("Warning"), summary)
+		default:
+			fmt.Fprintf(w, "%s\n", summary)
+		}
+		if d.Location.File != "" {
+			fmt.Fprintf(w, "  in %s\n", d.Location)
+		}
+		if d.Detail != "" {
+			fmt.Fprintf(w, "\n%s\n", redact.String(d.Detail))
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func countDiagnostics(diags diag.Diagnostics) (errors int, warnings int) {
+	for _, d := range diags {
+		switch d.Severity {
+		case diag.Error:
+			errors++
+		case diag.Warning:
+			warnings++
+		}
+	}
+	return
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
 func newValidateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "validate",
 		Short: "Validate configuration",
+		Long: `Validate configuration.
+
+Problems with the configuration are reported as errors and warnings, including
+the location in the configuration files where they originate. Warnings include
+unknown fields, which are ignored, and missing required fields.
+
+The values of sensitive variables are redacted in the output. Note that they
+are not redacted in the Terraform configuration and state written on deployment.
+
+The command exits with a non-zero exit code if any errors are found.
+Specify --fail-on-warnings to also exit with a non-zero exit code on warnings.`,
 
 		PreRunE: ConfigureBundleWithVariables,
 	}
 
+	var failOnWarnings bool
+	cmd.Flags().BoolVar(&failOnWarnings, "fail-on-warnings", false, "Exit with a non-zero exit code if any warnings are found.")
+
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		b := bundle.Get(cmd.Context())
 
+		// Diagnostics produced while loading the configuration files.
+		diags := b.Config.Diagnostics()
+
 		err := bundle.Apply(cmd.Context(), b, phases.Initialize())
+		if err != nil {
+			renderDiagnostics(cmd.ErrOrStderr(), diags)
+			return err
+		}
+
+		v, err := b.Config.Value()
 		if err != nil {
 			return err
 		}
 
+		schemaDiags, err := schema.Validate(v)
+		if err != nil {
+			return err
+		}
+
+		diags = diags.Extend(schemaDiags)
+		renderDiagnostics(cmd.ErrOrStderr(), diags)
+
 		buf, err := json.MarshalIndent(b.Config, "", "  ")
 		if err != nil {
 			return err
 		}
-		cmd.OutOrStdout().Write(buf)
+		// Values of sensitive variables are replaced wherever they appear.
+		cmd.OutOrStdout().Write(redact.JSON(buf))
+
+		errors, warnings := countDiagnostics(diags)
+		if errors > 0 || (failOnWarnings && warnings > 0) {
+			return fmt.Errorf("validation failed: found %s and %s", plural(errors, "error"), plural(warnings, "warning"))
+		}
 		return nil
 	}
 
@@ -18,11 +18,22 @@ func ConfigureBundleWithVariables(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Initialize variables by assigning them values passed as command line flags
+	variableFiles, err := cmd.Flags().GetStringSlice("var-file")
+	if err != nil {
+		return err
+	}
+
+	// Initialize variables by assigning them values passed as command line flags.
+	// Values passed through --var take precedence over values in files passed through --var-file.
 	b := bundle.Get(cmd.Context())
-	return b.Config.InitializeVariables(variables)
+	err = b.Config.InitializeVariables(variables)
+	if err != nil {
+		return err
+	}
+	return b.Config.InitializeVariablesFromFiles(variableFiles)
 }
 
 func initVariableFlag(cmd *cobra.Command) {
-	cmd.PersistentFlags().StringSlice("var", []string{}, `set values for variables defined in bundle config. Example: --var="foo=bar"`)
+	cmd.PersistentFlags().StringSlice("var", []string{}, `set values for variables defined in bundle config. Values of variables with a list, map or object type are specified as JSON. Example: --var="foo=bar"`)
+	cmd.PersistentFlags().StringSlice("var-file", []string{}, `set values for variables defined in bundle config from a JSON or YAML file. Values in later files take precedence. Example: --var-file=vars.json`)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/databricks/cli/bundle/config/mutator"
 	"github.com/databricks/cli/bundle/env"
 	envlib "github.com/databricks/cli/libs/env"
+	"github.com/databricks/cli/libs/log"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
 )
@@ -72,6 +73,11 @@ func loadBundle(cmd *cobra.Command, args []string, load func(ctx context.Context
 		return nil, err
 	}
 
+	// Surface problems in the configuration files (e.g. unknown fields).
+	for _, d := range b.Config.Diagnostics() {
+		log.Warnf(ctx, "%s", d)
+	}
+
 	return b, nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/databricks/cli/libs/env"
 	"github.com/databricks/cli/libs/flags"
 	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/redact"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
@@ -57,13 +58,13 @@ func (l *friendlyHandler) Handle(ctx context.Context, rec slog.Record) error {
 		attrs += fmt.Sprintf(" %s%s%s",
 			color.CyanString(a.Key),
 			color.CyanString("="),
-			color.YellowString(a.Value.String()))
+			color.YellowString(redact.String(a.Value.String())))
 		return true
 	})
 	msg := fmt.Sprintf("%s %s %s%s\n",
 		color.MagentaString(t),
 		l.coloredLevel(rec),
-		rec.Message,
+		redact.String(rec.Message),
 		attrs)
 	_, err := l.w.Write([]byte(msg))
 	return err
@@ -106,6 +107,7 @@ func (f *logFlags) initializeContext(ctx context.Context) (context.Context, erro
 	opts.ReplaceAttr = log.ReplaceAttrFunctions{
 		log.ReplaceLevelAttr,
 		log.ReplaceSourceAttr,
+		log.ReplaceRedactedAttr,
 	}.ReplaceAttr
 
 	// Open the underlying log file if the user configured an actual file to log to.
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -21,7 +22,25 @@ type syncFlags struct {
 	interval time.Duration
 	full     bool
 	watch    bool
+	hash     bool
+	dryRun   bool
 	output   flags.Output
+
+	// download remote changes and resolve conflicts
+	pull         bool
+	preferLocal  bool
+	preferRemote bool
+}
+
+func (f *syncFlags) conflictStrategy() sync.ConflictStrategy {
+	switch {
+	case f.preferLocal:
+		return sync.ConflictPreferLocal
+	case f.preferRemote:
+		return sync.ConflictPreferRemote
+	default:
+		return sync.ConflictAbort
+	}
 }
 
 func (f *syncFlags) syncOptionsFromBundle(cmd *cobra.Command, args []string, b *bundle.Bundle) (*sync.SyncOptions, error) {
@@ -45,6 +64,10 @@ func (f *syncFlags) syncOptionsFromBundle(cmd *cobra.Command, args []string, b *
 		Include:      includes,
 		Exclude:      b.Config.Sync.Exclude,
 		Full:         f.full,
+		ContentHash:  f.hash,
+		DryRun:       f.dryRun,
+		Pull:         f.pull,
+		Conflicts:    f.conflictStrategy(),
 		PollInterval: f.interval,
 
 		SnapshotBasePath: cacheDir,
@@ -62,6 +85,10 @@ func (f *syncFlags) syncOptionsFromArgs(cmd *cobra.Command, args []string) (*syn
 		LocalPath:    args[0],
 		RemotePath:   args[1],
 		Full:         f.full,
+		ContentHash:  f.hash,
+		DryRun:       f.dryRun,
+		Pull:         f.pull,
+		Conflicts:    f.conflictStrategy(),
 		PollInterval: f.interval,
 
 		// We keep existing behavior for VS Code extension where if there is
@@ -78,16 +105,42 @@ func New() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "sync [flags] SRC DST",
 		Short: "Synchronize a local directory to a workspace directory",
-		Args:  cobra.MaximumNArgs(2),
+		Long: `Synchronize a local directory to a workspace directory.
+
+Local changes since the last sync are uploaded to the workspace directory.
+
+DST can also be a path in DBFS (e.g. dbfs:/tmp/project) or in a Unity Catalog
+volume (e.g. /Volumes/main/default/files/project). Notebooks are synchronized
+as plain files to these destinations. Pulling remote changes is not supported
+for volumes.
+
+Specify --pull to first download changes made in the workspace directory since
+the last sync, for example to notebooks edited in the workspace. Notebooks are
+exported in their source format. If a file was changed both locally and in the
+workspace, the sync is aborted and the conflicting files are reported. Specify
+--prefer-local or --prefer-remote to keep the local or the remote changes instead.
+
+Specify --dry-run to print the files and directories that would be uploaded
+and deleted, without changing the workspace directory or the sync snapshot.`,
+		Args: cobra.MaximumNArgs(2),
 	}
 
 	f := syncFlags{
 		output: flags.OutputText,
 	}
-	cmd.Flags().DurationVar(&f.interval, "interval", 1*time.Second, "file system polling interval (for --watch)")
+	cmd.Flags().DurationVar(&f.interval, "interval", 1*time.Second, "file system polling interval (for --watch, if file system events cannot be watched)")
 	cmd.Flags().BoolVar(&f.full, "full", false, "perform full synchronization (default is incremental)")
 	cmd.Flags().BoolVar(&f.watch, "watch", false, "watch local file system for changes")
+	cmd.Flags().BoolVar(&f.hash, "content-hash", false, "detect changes by comparing content hashes of files (default is modified times only)")
+	cmd.Flags().BoolVar(&f.dryRun, "dry-run", false, "print the changes that would be synchronized without applying them")
+	cmd.Flags().BoolVar(&f.pull, "pull", false, "download remote changes since the last sync before uploading local changes")
+	cmd.Flags().BoolVar(&f.preferLocal, "prefer-local", false, "with --pull, keep local changes to files that were also changed remotely")
+	cmd.Flags().BoolVar(&f.preferRemote, "prefer-remote", false, "with --pull, keep remote changes to files that were also changed locally")
 	cmd.Flags().Var(&f.output, "output", "type of output format")
+	cmd.MarkFlagsMutuallyExclusive("prefer-local", "prefer-remote")
+	cmd.MarkFlagsMutuallyExclusive("pull", "watch")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "watch")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "pull")
 
 	// Wrapper for [root.MustWorkspaceClient] that disables loading authentication configuration from a bundle.
 	mustWorkspaceClient := func(cmd *cobra.Command, args []string) error {
@@ -120,6 +173,10 @@ func New() *cobra.Command {
 			return err
 		}
 
+		if (f.preferLocal || f.preferRemote) && !f.pull {
+			return fmt.Errorf("--prefer-local and --prefer-remote can only be used with --pull")
+		}
+
 		ctx := cmd.Context()
 		s, err := sync.New(ctx, *opts)
 		if err != nil {
@@ -151,6 +208,11 @@ func New() *cobra.Command {
 
 		s.Close()
 		wg.Wait()
+
+		var cerr *sync.ConflictError
+		if errors.As(err, &cerr) {
+			return fmt.Errorf("%w\n\nRerun with --prefer-local to keep the local changes or --prefer-remote to keep the remote changes", err)
+		}
 		return err
 	}
 
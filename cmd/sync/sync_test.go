@@ -9,6 +9,7 @@ import (
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/sync"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -58,3 +59,13 @@ func TestSyncOptionsFromArgs(t *testing.T) {
 	assert.Equal(t, "/local", opts.LocalPath)
 	assert.Equal(t, "/remote", opts.RemotePath)
 }
+
+func TestSyncOptionsFromArgsPull(t *testing.T) {
+	f := syncFlags{pull: true, preferRemote: true}
+	cmd := New()
+	cmd.SetContext(root.SetWorkspaceClient(context.Background(), nil))
+	opts, err := f.syncOptionsFromArgs(cmd, []string{"/local", "/remote"})
+	require.NoError(t, err)
+	assert.True(t, opts.Pull)
+	assert.Equal(t, sync.ConflictPreferRemote, opts.Conflicts)
+}
@@ -6,6 +6,7 @@ require (
 	github.com/briandowns/spinner v1.23.0 // Apache 2.0
 	github.com/databricks/databricks-sdk-go v0.25.0 // Apache 2.0
 	github.com/fatih/color v1.16.0 // MIT
+	github.com/fsnotify/fsnotify v1.7.0 // BSD-3-Clause
 	github.com/ghodss/yaml v1.0.0 // MIT + NOTICE
 	github.com/google/uuid v1.4.0 // BSD-3-Clause
 	github.com/hashicorp/go-version v1.6.0 // MPL 2.0
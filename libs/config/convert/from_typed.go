@@ -12,6 +12,20 @@ import (
 func FromTyped(src any, ref config.Value) (config.Value, error) {
 	srcv := reflect.ValueOf(src)
 
+	// The source is a nil interface value.
+	if !srcv.IsValid() {
+		return config.NilValue, nil
+	}
+
+	// A reference to a value that is not a string cannot be represented in the
+	// typed configuration until it is resolved. Retain it if the typed value is unset.
+	if ref.IsPureReference() && baseKind(srcv.Type()) != reflect.String {
+		if srcv.IsZero() {
+			return ref, nil
+		}
+		ref = config.NilValue
+	}
+
 	// Dereference pointer if necessary
 	for srcv.Kind() == reflect.Pointer {
 		if srcv.IsNil() {
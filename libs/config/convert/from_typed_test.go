@@ -392,3 +392,42 @@ func TestFromTypedFloatTypeError(t *testing.T) {
 	_, err := FromTyped(src, ref)
 	require.Error(t, err)
 }
+
+func TestFromTypedPureReferenceRetained(t *testing.T) {
+	type Tmp struct {
+		Struct *struct {
+			Foo string `json:"foo"`
+		} `json:"struct"`
+		Int int `json:"int"`
+	}
+
+	ref := config.V(map[string]config.Value{
+		"struct": config.V("${var.struct}"),
+		"int":    config.V("${var.int}"),
+	})
+
+	nv, err := FromTyped(Tmp{}, ref)
+	require.NoError(t, err)
+	assert.Equal(t, ref.AsAny(), nv.AsAny())
+
+	// A value that is set in the typed configuration takes precedence.
+	nv, err = FromTyped(Tmp{Int: 5}, ref)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"struct": "${var.struct}",
+		"int":    int64(5),
+	}, nv.AsAny())
+}
+
+func TestFromTypedInterface(t *testing.T) {
+	type Tmp struct {
+		Any any `json:"any"`
+		Nil any `json:"nil"`
+	}
+
+	nv, err := FromTyped(Tmp{Any: map[string]any{"foo": []any{"bar", int64(1)}}}, config.NilValue)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"any": map[string]any{"foo": []any{"bar", int64(1)}},
+	}, nv.AsAny())
+}
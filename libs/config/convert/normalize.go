@@ -3,36 +3,68 @@ package convert
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 
 	"github.com/databricks/cli/libs/config"
 	"github.com/databricks/cli/libs/diag"
 )
 
-func Normalize(dst any, src config.Value) (config.Value, diag.Diagnostics) {
-	return normalizeType(reflect.TypeOf(dst), src)
+// NormalizeOption configures the behavior of [Normalize].
+type NormalizeOption int
+
+const (
+	// IncludeMissingFields includes fields of the destination type that are not
+	// set in the source value, using their zero value. Missing pointer fields are
+	// only included if they point to a struct, so that unset values remain unset.
+	IncludeMissingFields NormalizeOption = iota
+)
+
+type normalizeOptions struct {
+	includeMissingFields bool
+}
+
+func Normalize(dst any, src config.Value, opts ...NormalizeOption) (config.Value, diag.Diagnostics) {
+	var n normalizeOptions
+	for _, opt := range opts {
+		switch opt {
+		case IncludeMissingFields:
+			n.includeMissingFields = true
+		}
+	}
+
+	return n.normalizeType(reflect.TypeOf(dst), src, []reflect.Type{})
 }
 
-func normalizeType(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
+func (n normalizeOptions) normalizeType(typ reflect.Type, src config.Value, seen []reflect.Type) (config.Value, diag.Diagnostics) {
 	for typ.Kind() == reflect.Pointer {
 		typ = typ.Elem()
 	}
 
+	// A reference may resolve to a value of any type upon interpolation,
+	// so it is retained as is until then.
+	if typ.Kind() != reflect.String && src.IsPureReference() {
+		return src, nil
+	}
+
 	switch typ.Kind() {
+	case reflect.Interface:
+		// Any value can be assigned to an interface.
+		return src, nil
 	case reflect.Struct:
-		return normalizeStruct(typ, src)
+		return n.normalizeStruct(typ, src, append(seen, typ))
 	case reflect.Map:
-		return normalizeMap(typ, src)
+		return n.normalizeMap(typ, src, seen)
 	case reflect.Slice:
-		return normalizeSlice(typ, src)
+		return n.normalizeSlice(typ, src, seen)
 	case reflect.String:
-		return normalizeString(typ, src)
+		return n.normalizeString(typ, src)
 	case reflect.Bool:
-		return normalizeBool(typ, src)
+		return n.normalizeBool(typ, src)
 	case reflect.Int, reflect.Int32, reflect.Int64:
-		return normalizeInt(typ, src)
+		return n.normalizeInt(typ, src)
 	case reflect.Float32, reflect.Float64:
-		return normalizeFloat(typ, src)
+		return n.normalizeFloat(typ, src)
 	}
 
 	return config.NilValue, diag.Errorf("unsupported type: %s", typ.Kind())
@@ -46,7 +78,7 @@ func typeMismatch(expected config.Kind, src config.Value) diag.Diagnostic {
 	}
 }
 
-func normalizeStruct(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
+func (n normalizeOptions) normalizeStruct(typ reflect.Type, src config.Value, seen []reflect.Type) (config.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	switch src.Kind() {
@@ -56,16 +88,20 @@ func normalizeStruct(typ reflect.Type, src config.Value) (config.Value, diag.Dia
 		for k, v := range src.MustMap() {
 			index, ok := info.Fields[k]
 			if !ok {
+				summary := fmt.Sprintf("unknown field: %s", k)
+				if suggestion := suggestField(k, info.Fields); suggestion != "" {
+					summary = fmt.Sprintf("%s (did you mean %s?)", summary, suggestion)
+				}
 				diags = diags.Append(diag.Diagnostic{
 					Severity: diag.Warning,
-					Summary:  fmt.Sprintf("unknown field: %s", k),
-					Location: src.Location(),
+					Summary:  summary,
+					Location: v.Location(),
 				})
 				continue
 			}
 
 			// Normalize the value according to the field type.
-			v, err := normalizeType(typ.FieldByIndex(index).Type, v)
+			v, err := n.normalizeType(typ.FieldByIndex(index).Type, v, seen)
 			if err != nil {
 				diags = diags.Extend(err)
 				// Skip the element if it cannot be normalized.
@@ -77,6 +113,10 @@ func normalizeStruct(typ reflect.Type, src config.Value) (config.Value, diag.Dia
 			out[k] = v
 		}
 
+		if n.includeMissingFields {
+			n.addMissingFields(typ, out, seen)
+		}
+
 		return config.NewValue(out, src.Location()), diags
 	case config.KindNil:
 		return src, diags
@@ -85,7 +125,51 @@ func normalizeStruct(typ reflect.Type, src config.Value) (config.Value, diag.Dia
 	return config.NilValue, diags.Append(typeMismatch(config.KindMap, src))
 }
 
-func normalizeMap(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
+// addMissingFields adds the zero value for every field of the struct type
+// that is not present in the specified map.
+func (n normalizeOptions) addMissingFields(typ reflect.Type, out map[string]config.Value, seen []reflect.Type) {
+	info := getStructInfo(typ)
+	for k, index := range info.Fields {
+		if _, ok := out[k]; ok {
+			continue
+		}
+
+		ftyp := typ.FieldByIndex(index).Type
+		isPointer := ftyp.Kind() == reflect.Pointer
+		for ftyp.Kind() == reflect.Pointer {
+			ftyp = ftyp.Elem()
+		}
+
+		// Unset pointers to scalar values remain unset.
+		if isPointer && ftyp.Kind() != reflect.Struct {
+			continue
+		}
+
+		var v config.Value
+		switch ftyp.Kind() {
+		case reflect.Struct:
+			// Skip recursive types to avoid infinite recursion.
+			if slices.Contains(seen, ftyp) {
+				continue
+			}
+			v, _ = n.normalizeType(ftyp, config.V(map[string]config.Value{}), seen)
+		case reflect.String:
+			v = config.V("")
+		case reflect.Bool:
+			v = config.V(false)
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			v = config.V(int64(0))
+		case reflect.Float32, reflect.Float64:
+			v = config.V(float64(0))
+		default:
+			continue
+		}
+
+		out[k] = v
+	}
+}
+
+func (n normalizeOptions) normalizeMap(typ reflect.Type, src config.Value, seen []reflect.Type) (config.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	switch src.Kind() {
@@ -93,7 +177,7 @@ func normalizeMap(typ reflect.Type, src config.Value) (config.Value, diag.Diagno
 		out := make(map[string]config.Value)
 		for k, v := range src.MustMap() {
 			// Normalize the value according to the map element type.
-			v, err := normalizeType(typ.Elem(), v)
+			v, err := n.normalizeType(typ.Elem(), v, seen)
 			if err != nil {
 				diags = diags.Extend(err)
 				// Skip the element if it cannot be normalized.
@@ -113,7 +197,7 @@ func normalizeMap(typ reflect.Type, src config.Value) (config.Value, diag.Diagno
 	return config.NilValue, diags.Append(typeMismatch(config.KindMap, src))
 }
 
-func normalizeSlice(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
+func (n normalizeOptions) normalizeSlice(typ reflect.Type, src config.Value, seen []reflect.Type) (config.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	switch src.Kind() {
@@ -121,7 +205,7 @@ func normalizeSlice(typ reflect.Type, src config.Value) (config.Value, diag.Diag
 		out := make([]config.Value, 0, len(src.MustSequence()))
 		for _, v := range src.MustSequence() {
 			// Normalize the value according to the slice element type.
-			v, err := normalizeType(typ.Elem(), v)
+			v, err := n.normalizeType(typ.Elem(), v, seen)
 			if err != nil {
 				diags = diags.Extend(err)
 				// Skip the element if it cannot be normalized.
@@ -141,7 +225,7 @@ func normalizeSlice(typ reflect.Type, src config.Value) (config.Value, diag.Diag
 	return config.NilValue, diags.Append(typeMismatch(config.KindSequence, src))
 }
 
-func normalizeString(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
+func (n normalizeOptions) normalizeString(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var out string
 
@@ -161,7 +245,7 @@ func normalizeString(typ reflect.Type, src config.Value) (config.Value, diag.Dia
 	return config.NewValue(out, src.Location()), diags
 }
 
-func normalizeBool(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
+func (n normalizeOptions) normalizeBool(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var out bool
 
@@ -186,7 +270,7 @@ func normalizeBool(typ reflect.Type, src config.Value) (config.Value, diag.Diagn
 	return config.NewValue(out, src.Location()), diags
 }
 
-func normalizeInt(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
+func (n normalizeOptions) normalizeInt(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var out int64
 
@@ -210,7 +294,7 @@ func normalizeInt(typ reflect.Type, src config.Value) (config.Value, diag.Diagno
 	return config.NewValue(out, src.Location()), diags
 }
 
-func normalizeFloat(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
+func (n normalizeOptions) normalizeFloat(typ reflect.Type, src config.Value) (config.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var out float64
 
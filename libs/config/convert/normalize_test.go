@@ -67,7 +67,7 @@ func TestNormalizeStructUnknownField(t *testing.T) {
 	assert.Equal(t, diag.Diagnostic{
 		Severity: diag.Warning,
 		Summary:  `unknown field: bar`,
-		Location: vin.Get("foo").Location(),
+		Location: vin.Get("bar").Location(),
 	}, err[0])
 
 	// The field that can be mapped to the struct field is retained.
@@ -76,6 +76,22 @@ func TestNormalizeStructUnknownField(t *testing.T) {
 	}, vout.AsAny())
 }
 
+func TestNormalizeStructUnknownFieldSuggestion(t *testing.T) {
+	type Tmp struct {
+		Name string `json:"name"`
+	}
+
+	var typ Tmp
+	vin := config.V(map[string]config.Value{
+		"nmae": config.V("bar"),
+	})
+
+	_, err := Normalize(typ, vin)
+	assert.Len(t, err, 1)
+	assert.Equal(t, diag.Warning, err[0].Severity)
+	assert.Equal(t, `unknown field: nmae (did you mean name?)`, err[0].Summary)
+}
+
 func TestNormalizeStructNil(t *testing.T) {
 	type Tmp struct {
 		Foo string `json:"foo"`
@@ -433,3 +449,95 @@ func TestNormalizeFloatError(t *testing.T) {
 		Location: config.Location{},
 	}, err[0])
 }
+
+func TestNormalizeStructIncludeMissingFields(t *testing.T) {
+	type Nested struct {
+		String string `json:"string"`
+	}
+
+	type Tmp struct {
+		// Verify that fields that are already set in the dynamic value are not overridden.
+		Existing string `json:"existing"`
+
+		// Verify that all primitive fields are set.
+		String  string  `json:"string"`
+		Bool    bool    `json:"bool"`
+		Int     int     `json:"int"`
+		Float64 float64 `json:"float64"`
+
+		// Verify that nested structs are set, also through pointers.
+		Nested    Nested  `json:"nested"`
+		NestedPtr *Nested `json:"nested_ptr"`
+
+		// Verify that unset pointers to primitive values remain unset.
+		StringPtr *string `json:"string_ptr"`
+
+		// Verify that maps and slices are not set.
+		Map   map[string]string `json:"map"`
+		Slice []string          `json:"slice"`
+	}
+
+	var typ Tmp
+	vin := config.V(map[string]config.Value{
+		"existing": config.V("already set"),
+	})
+
+	vout, err := Normalize(typ, vin, IncludeMissingFields)
+	assert.Empty(t, err)
+	assert.Equal(t, map[string]any{
+		"existing": "already set",
+		"string":   "",
+		"bool":     false,
+		"int":      int64(0),
+		"float64":  float64(0),
+		"nested": map[string]any{
+			"string": "",
+		},
+		"nested_ptr": map[string]any{
+			"string": "",
+		},
+	}, vout.AsAny())
+}
+
+func TestNormalizePureReference(t *testing.T) {
+	type Tmp struct {
+		Struct *struct {
+			Foo string `json:"foo"`
+		} `json:"struct"`
+		Int  int               `json:"int"`
+		Bool bool              `json:"bool"`
+		Map  map[string]string `json:"map"`
+	}
+
+	var typ Tmp
+	vin := config.V(map[string]config.Value{
+		"struct": config.V("${var.struct}"),
+		"int":    config.V("${var.int}"),
+		"bool":   config.V("${var.bool}"),
+		"map":    config.V("${var.map}"),
+	})
+
+	vout, err := Normalize(typ, vin)
+	assert.Empty(t, err)
+	assert.Equal(t, vin.AsAny(), vout.AsAny())
+}
+
+func TestNormalizeInterface(t *testing.T) {
+	type Tmp struct {
+		Any any `json:"any"`
+	}
+
+	var typ Tmp
+	vin := config.V(map[string]config.Value{
+		"any": config.V([]config.Value{
+			config.V("foo"),
+			config.V(map[string]config.Value{
+				"bar": config.V(1),
+			}),
+		}),
+	})
+
+	vout, err := Normalize(typ, vin)
+	assert.Empty(t, err)
+	assert.Equal(t, vin.AsAny(), vout.AsAny())
+}
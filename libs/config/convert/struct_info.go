@@ -131,3 +131,11 @@ func (s *structInfo) FieldValues(v reflect.Value) map[string]reflect.Value {
 
 // Type of [config.Value].
 var configValueType = reflect.TypeOf((*config.Value)(nil)).Elem()
+
+// baseKind returns the kind of the type after dereferencing any pointers.
+func baseKind(typ reflect.Type) reflect.Kind {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ.Kind()
+}
@@ -0,0 +1,55 @@
+package convert
+
+import "sort"
+
+// editDistance returns the optimal string alignment distance between a and b.
+// This is the Levenshtein distance where a transposition of two adjacent
+// characters counts as a single edit, which is how most typos come about.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(ra)][len(rb)]
+}
+
+// suggestField returns the field name closest to the specified (unknown) name,
+// or an empty string if none of the fields is close enough to be a likely typo.
+func suggestField(name string, fields map[string][]int) string {
+	// Sort candidates for a deterministic result if multiple are equally close.
+	candidates := make([]string, 0, len(fields))
+	for k := range fields {
+		candidates = append(candidates, k)
+	}
+	sort.Strings(candidates)
+
+	best := ""
+	bestDistance := max(1, len(name)/3) + 1
+	for _, candidate := range candidates {
+		if d := editDistance(name, candidate); d < bestDistance {
+			best = candidate
+			bestDistance = d
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,29 @@
+package convert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("name", "name"))
+	assert.Equal(t, 1, editDistance("nmae", "name"))
+	assert.Equal(t, 1, editDistance("taks", "task"))
+	assert.Equal(t, 1, editDistance("tasks", "task"))
+	assert.Equal(t, 3, editDistance("foo", "bar"))
+	assert.Equal(t, 4, editDistance("", "name"))
+}
+
+func TestSuggestField(t *testing.T) {
+	fields := map[string][]int{
+		"name":        {0},
+		"tasks":       {1},
+		"max_retries": {2},
+	}
+
+	assert.Equal(t, "name", suggestField("nmae", fields))
+	assert.Equal(t, "tasks", suggestField("taks", fields))
+	assert.Equal(t, "max_retries", suggestField("max_retry", fields))
+	assert.Equal(t, "", suggestField("foo", fields))
+}
@@ -19,6 +19,12 @@ func ToTyped(dst any, src config.Value) error {
 			dstv.SetZero()
 			return nil
 		}
+		// A reference to a value that is not a string cannot be represented
+		// until it is resolved, so the destination is left unset.
+		if dstv.CanSet() && src.IsPureReference() && baseKind(dstv.Type()) != reflect.String {
+			dstv.SetZero()
+			return nil
+		}
 		if dstv.IsNil() {
 			dstv.Set(reflect.New(dstv.Type().Elem()))
 		}
@@ -30,7 +36,14 @@ func ToTyped(dst any, src config.Value) error {
 		panic("cannot set destination value")
 	}
 
+	if src.IsPureReference() && dstv.Kind() != reflect.String && dstv.Kind() != reflect.Interface {
+		dstv.SetZero()
+		return nil
+	}
+
 	switch dstv.Kind() {
+	case reflect.Interface:
+		return toTypedInterface(dstv, src)
 	case reflect.Struct:
 		return toTypedStruct(dstv, src)
 	case reflect.Map:
@@ -50,6 +63,16 @@ func ToTyped(dst any, src config.Value) error {
 	return fmt.Errorf("unsupported type: %s", dstv.Kind())
 }
 
+func toTypedInterface(dst reflect.Value, src config.Value) error {
+	if src.Kind() == config.KindNil {
+		dst.SetZero()
+		return nil
+	}
+
+	dst.Set(reflect.ValueOf(src.AsAny()))
+	return nil
+}
+
 func toTypedStruct(dst reflect.Value, src config.Value) error {
 	switch src.Kind() {
 	case config.KindMap:
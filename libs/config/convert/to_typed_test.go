@@ -334,7 +334,7 @@ func TestToTypedBoolFromString(t *testing.T) {
 	}
 
 	// Other
-	err := ToTyped(&out, config.V("${var.foo}"))
+	err := ToTyped(&out, config.V("maybe"))
 	require.Error(t, err)
 }
 
@@ -446,3 +446,39 @@ func TestToTypedFloat64FromString(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, float64(1.2), out)
 }
+
+func TestToTypedPureReference(t *testing.T) {
+	type Tmp struct {
+		Struct *struct {
+			Foo string `json:"foo"`
+		} `json:"struct"`
+		Int    int    `json:"int"`
+		String string `json:"string"`
+	}
+
+	var out Tmp
+	v := config.V(map[string]config.Value{
+		"struct": config.V("${var.struct}"),
+		"int":    config.V("${var.int}"),
+		"string": config.V("${var.string}"),
+	})
+
+	err := ToTyped(&out, v)
+	require.NoError(t, err)
+	assert.Nil(t, out.Struct)
+	assert.Equal(t, 0, out.Int)
+	assert.Equal(t, "${var.string}", out.String)
+}
+
+func TestToTypedInterface(t *testing.T) {
+	var out any
+	err := ToTyped(&out, config.V(map[string]config.Value{
+		"foo": config.V([]config.Value{config.V("bar")}),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"foo": []any{"bar"}}, out)
+
+	err = ToTyped(&out, config.NilValue)
+	require.NoError(t, err)
+	assert.Nil(t, out)
+}
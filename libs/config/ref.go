@@ -0,0 +1,30 @@
+package config
+
+import "regexp"
+
+// pureReference matches a string that consists of a single reference, e.g. "${var.foo}".
+// The syntax of the reference matches the syntax used for interpolation.
+var pureReference = regexp.MustCompile(`^\$\{([a-zA-Z]+([-_]?[a-zA-Z0-9]+)*(\.[a-zA-Z]+([-_]?[a-zA-Z0-9]+)*)*)\}$`)
+
+// IsPureReference returns true if the string consists of a single reference
+// and nothing else. The value of such a string is replaced by the value it
+// refers to upon interpolation, which need not be a string.
+func IsPureReference(s string) bool {
+	return pureReference.MatchString(s)
+}
+
+// PureReference returns the path referred to by a string that consists of a
+// single reference, e.g. "var.foo" for "${var.foo}".
+func PureReference(s string) (string, bool) {
+	m := pureReference.FindStringSubmatch(s)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
+
+// IsPureReference returns true if the value is a string that consists of a single reference.
+func (v Value) IsPureReference() bool {
+	s, ok := v.v.(string)
+	return ok && IsPureReference(s)
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPureReference(t *testing.T) {
+	assert.True(t, IsPureReference("${var.foo}"))
+	assert.True(t, IsPureReference("${bundle.name}"))
+	assert.True(t, IsPureReference("${resources.jobs.my-job.id}"))
+	assert.False(t, IsPureReference("prefix-${var.foo}"))
+	assert.False(t, IsPureReference("${var.foo}-suffix"))
+	assert.False(t, IsPureReference("${var.foo} ${var.bar}"))
+	assert.False(t, IsPureReference("var.foo"))
+}
+
+func TestPureReference(t *testing.T) {
+	path, ok := PureReference("${var.foo}")
+	assert.True(t, ok)
+	assert.Equal(t, "var.foo", path)
+
+	_, ok = PureReference("foo ${var.foo}")
+	assert.False(t, ok)
+}
+
+func TestValueIsPureReference(t *testing.T) {
+	assert.True(t, V("${var.foo}").IsPureReference())
+	assert.False(t, V("foo").IsPureReference())
+	assert.False(t, V(1).IsPureReference())
+	assert.False(t, NilValue.IsPureReference())
+}
@@ -0,0 +1,122 @@
+package yamlsaver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/databricks/cli/libs/config"
+	"gopkg.in/yaml.v3"
+)
+
+// Saver writes configuration values as YAML.
+type Saver struct {
+	// Keys that are written before all other keys in a mapping, in this order.
+	// Remaining keys are written in alphabetical order.
+	order []string
+}
+
+// NewSaver returns a saver that writes the specified keys first in every mapping.
+func NewSaver(order ...string) *Saver {
+	return &Saver{order: order}
+}
+
+// SaveAsYAML writes the value to the file at the specified path.
+// It refuses to overwrite an existing file unless force is set.
+func (s *Saver) SaveAsYAML(v config.Value, path string, force bool) error {
+	if !force {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists. Use --force to overwrite", path)
+		}
+	}
+
+	node, err := s.ToYamlNode(v)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := yaml.NewEncoder(f)
+	enc.SetIndent(2)
+	err = enc.Encode(node)
+	if err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// ToYamlNode converts a configuration value to a YAML node.
+func (s *Saver) ToYamlNode(v config.Value) (*yaml.Node, error) {
+	switch v.Kind() {
+	case config.KindMap:
+		m := v.MustMap()
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			oi, oj := s.rank(keys[i]), s.rank(keys[j])
+			if oi != oj {
+				return oi < oj
+			}
+			return keys[i] < keys[j]
+		})
+
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, k := range keys {
+			item, err := s.ToYamlNode(m[k])
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: k}, item)
+		}
+		return node, nil
+	case config.KindSequence:
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, e := range v.MustSequence() {
+			item, err := s.ToYamlNode(e)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, item)
+		}
+		return node, nil
+	case config.KindNil:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	case config.KindString:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v.MustString()}, nil
+	case config.KindBool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v.MustBool())}, nil
+	case config.KindInt:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatInt(v.MustInt(), 10)}, nil
+	case config.KindFloat:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: strconv.FormatFloat(v.MustFloat(), 'g', -1, 64)}, nil
+	case config.KindTime:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!timestamp", Value: v.MustTime().Format(time.RFC3339)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported kind: %s", v.Kind())
+	}
+}
+
+// rank returns the position of the key in the preferred order,
+// or the length of the order if the key is not in it.
+func (s *Saver) rank(key string) int {
+	if i := slices.Index(s.order, key); i >= 0 {
+		return i
+	}
+	return len(s.order)
+}
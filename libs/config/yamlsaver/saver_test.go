@@ -0,0 +1,64 @@
+package yamlsaver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/libs/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveAsYAML(t *testing.T) {
+	v := config.V(map[string]config.Value{
+		"tasks": config.V([]config.Value{
+			config.V(map[string]config.Value{
+				"notebook_task": config.V(map[string]config.Value{
+					"notebook_path": config.V("../src/notebook.py"),
+				}),
+				"task_key": config.V("main"),
+			}),
+		}),
+		"name":                config.V("My Job"),
+		"max_concurrent_runs": config.V(int64(1)),
+		"enabled":             config.V(true),
+		"ratio":               config.V(0.5),
+		"version":             config.V("1"),
+		"nothing":             config.NilValue,
+	})
+
+	path := filepath.Join(t.TempDir(), "resources", "job.yml")
+	err := NewSaver("name", "task_key").SaveAsYAML(v, path, false)
+	require.NoError(t, err)
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `name: My Job
+enabled: true
+max_concurrent_runs: 1
+nothing: null
+ratio: 0.5
+tasks:
+  - task_key: main
+    notebook_task:
+      notebook_path: ../src/notebook.py
+version: "1"
+`, string(raw))
+}
+
+func TestSaveAsYAMLDoesNotOverwrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "job.yml")
+	err := os.WriteFile(path, []byte("existing"), 0644)
+	require.NoError(t, err)
+
+	v := config.V(map[string]config.Value{"name": config.V("foo")})
+	err = NewSaver().SaveAsYAML(v, path, false)
+	assert.ErrorContains(t, err, "job.yml already exists. Use --force to overwrite")
+
+	err = NewSaver().SaveAsYAML(v, path, true)
+	require.NoError(t, err)
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "name: foo\n", string(raw))
+}
@@ -1,7 +1,9 @@
 package diag
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/databricks/cli/libs/config"
 )
@@ -22,6 +24,14 @@ type Diagnostic struct {
 	Location config.Location
 }
 
+// String returns the summary of the diagnostic, prefixed with its location if set.
+func (d Diagnostic) String() string {
+	if d.Location.File == "" {
+		return d.Summary
+	}
+	return fmt.Sprintf("%s: %s", d.Location, d.Summary)
+}
+
 // Errorf creates a new error diagnostic.
 func Errorf(format string, args ...any) Diagnostics {
 	return []Diagnostic{
@@ -74,3 +84,19 @@ func (ds Diagnostics) HasError() bool {
 	}
 	return false
 }
+
+// AsError returns an error that describes the diagnostics with error severity,
+// including their source location. It returns nil if there are no errors.
+func (ds Diagnostics) AsError() error {
+	var msgs []string
+	for _, d := range ds {
+		if d.Severity != Error {
+			continue
+		}
+		msgs = append(msgs, d.String())
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(msgs, "\n"))
+}
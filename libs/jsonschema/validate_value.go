@@ -0,0 +1,132 @@
+package jsonschema
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/databricks/cli/libs/config"
+	"github.com/databricks/cli/libs/diag"
+)
+
+// ValidateValue validates a configuration tree against the schema.
+// Every violation is returned as a diagnostic that includes the path to
+// the offending value and, where available, its location in the source files.
+//
+// Nil values are treated as absent, because the configuration tree
+// does not distinguish between a missing value and a nil value.
+//
+// Properties that are not defined in the schema are not reported. The schema
+// omits fields that are computed at runtime, and unknown fields in a
+// configuration tree are already reported when it is normalized.
+func (s *Schema) ValidateValue(v config.Value) diag.Diagnostics {
+	return s.validateValue("", v)
+}
+
+func joinValuePath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func valueDiagnostic(severity diag.Severity, v config.Value, format string, args ...any) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: severity,
+		Summary:  fmt.Sprintf(format, args...),
+		Location: v.Location(),
+	}
+}
+
+func (s *Schema) validateValue(path string, v config.Value) diag.Diagnostics {
+	if s == nil || v.Kind() == config.KindNil {
+		return nil
+	}
+
+	name := path
+	if name == "" {
+		name = "root"
+	}
+
+	if !kindMatchesType(v.Kind(), s.Type) {
+		return diag.Diagnostics{
+			valueDiagnostic(diag.Error, v, "%s: expected %s, found %s", name, s.Type, v.Kind()),
+		}
+	}
+
+	var diags diag.Diagnostics
+	switch v.Kind() {
+	case config.KindMap:
+		diags = diags.Extend(s.validateMapValue(path, v))
+	case config.KindSequence:
+		for i, item := range v.MustSequence() {
+			diags = diags.Extend(s.Items.validateValue(fmt.Sprintf("%s[%d]", path, i), item))
+		}
+	default:
+		if s.Enum != nil && !slices.ContainsFunc(s.Enum, func(e any) bool {
+			return fmt.Sprint(e) == fmt.Sprint(v.AsAny())
+		}) {
+			diags = diags.Append(valueDiagnostic(diag.Error, v, "%s: expected one of %v, found %v", name, s.Enum, v.AsAny()))
+		}
+		if err := validatePatternMatch(name, v.AsAny(), s); err != nil {
+			diags = diags.Append(valueDiagnostic(diag.Error, v, "%s", err))
+		}
+	}
+
+	return diags
+}
+
+func (s *Schema) validateMapValue(path string, v config.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m := v.MustMap()
+
+	// Iterate in a stable order so the diagnostics are deterministic.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		item := m[k]
+		if property, ok := s.Properties[k]; ok {
+			diags = diags.Extend(property.validateValue(joinValuePath(path, k), item))
+			continue
+		}
+
+		if additional, ok := s.AdditionalProperties.(*Schema); ok {
+			diags = diags.Extend(additional.validateValue(joinValuePath(path, k), item))
+		}
+	}
+
+	// The configuration tree cannot distinguish a required field that is absent
+	// from one that is set to its zero value, so these are reported as warnings.
+	for _, k := range s.Required {
+		if item, ok := m[k]; !ok || item.Kind() == config.KindNil {
+			diags = diags.Append(valueDiagnostic(diag.Warning, v, "missing required field: %s", joinValuePath(path, k)))
+		}
+	}
+
+	return diags
+}
+
+func kindMatchesType(kind config.Kind, typ Type) bool {
+	switch typ {
+	case "":
+		return true
+	case ObjectType:
+		return kind == config.KindMap
+	case ArrayType:
+		return kind == config.KindSequence
+	case StringType:
+		return kind == config.KindString
+	case BooleanType:
+		return kind == config.KindBool
+	case IntegerType:
+		return kind == config.KindInt
+	case NumberType:
+		return kind == config.KindInt || kind == config.KindFloat
+	default:
+		return false
+	}
+}
@@ -0,0 +1,130 @@
+package jsonschema
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/libs/config"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testValueSchema() *Schema {
+	return &Schema{
+		Type: ObjectType,
+		Properties: map[string]*Schema{
+			"name": {
+				Type: StringType,
+			},
+			"mode": {
+				Type: StringType,
+				Enum: []any{"development", "production"},
+			},
+			"tasks": {
+				Type: ArrayType,
+				Items: &Schema{
+					Type: ObjectType,
+					Properties: map[string]*Schema{
+						"task_key": {
+							Type: StringType,
+						},
+						"max_retries": {
+							Type: IntegerType,
+						},
+					},
+					Required:             []string{"task_key"},
+					AdditionalProperties: false,
+				},
+			},
+			"tags": {
+				Type: ObjectType,
+				AdditionalProperties: &Schema{
+					Type: StringType,
+				},
+			},
+		},
+		AdditionalProperties: false,
+	}
+}
+
+func TestValidateValueValid(t *testing.T) {
+	v := config.V(map[string]config.Value{
+		"name": config.V("foo"),
+		"mode": config.V("development"),
+		"tasks": config.V([]config.Value{
+			config.V(map[string]config.Value{
+				"task_key":    config.V("a"),
+				"max_retries": config.V(3),
+			}),
+		}),
+		"tags": config.V(map[string]config.Value{
+			"team": config.V("eng"),
+		}),
+	})
+
+	diags := testValueSchema().ValidateValue(v)
+	assert.Empty(t, diags)
+}
+
+func TestValidateValueTypeMismatch(t *testing.T) {
+	loc := config.Location{File: "databricks.yml", Line: 4, Column: 20}
+	v := config.V(map[string]config.Value{
+		"tasks": config.V([]config.Value{
+			config.V(map[string]config.Value{
+				"task_key":    config.V("a"),
+				"max_retries": config.NewValue("many", loc),
+			}),
+		}),
+		"tags": config.V(map[string]config.Value{
+			"team": config.V(true),
+		}),
+	})
+
+	diags := testValueSchema().ValidateValue(v)
+	require.Len(t, diags, 2)
+	assert.Equal(t, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "tags.team: expected string, found bool",
+	}, diags[0])
+	assert.Equal(t, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "tasks[0].max_retries: expected integer, found string",
+		Location: loc,
+	}, diags[1])
+}
+
+func TestValidateValueEnum(t *testing.T) {
+	v := config.V(map[string]config.Value{
+		"mode": config.V("staging"),
+	})
+
+	diags := testValueSchema().ValidateValue(v)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, "mode: expected one of [development production], found staging", diags[0].Summary)
+}
+
+func TestValidateValueRequiredFields(t *testing.T) {
+	v := config.V(map[string]config.Value{
+		"tasks": config.V([]config.Value{
+			config.V(map[string]config.Value{
+				"key": config.V("a"),
+			}),
+		}),
+	})
+
+	diags := testValueSchema().ValidateValue(v)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "missing required field: tasks[0].task_key", diags[0].Summary)
+}
+
+func TestValidateValueIgnoresNil(t *testing.T) {
+	v := config.V(map[string]config.Value{
+		"name":  config.NilValue,
+		"tasks": config.NilValue,
+	})
+
+	diags := testValueSchema().ValidateValue(v)
+	assert.Empty(t, diags)
+}
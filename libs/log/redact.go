@@ -0,0 +1,20 @@
+package log
+
+import (
+	"log/slog"
+
+	"github.com/databricks/cli/libs/redact"
+)
+
+// ReplaceRedactedAttr replaces sensitive values in string attributes
+// (including the message) with a placeholder. See [redact.Register].
+func ReplaceRedactedAttr(groups []string, a slog.Attr) slog.Attr {
+	switch a.Value.Kind() {
+	case slog.KindString, slog.KindAny:
+		s := a.Value.String()
+		if r := redact.String(s); r != s {
+			a.Value = slog.StringValue(r)
+		}
+	}
+	return a
+}
@@ -0,0 +1,24 @@
+package log
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/databricks/cli/libs/redact"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplaceRedactedAttr(t *testing.T) {
+	t.Cleanup(redact.Reset)
+	redact.Register("hunter2")
+
+	out := ReplaceRedactedAttr(nil, slog.String(slog.MessageKey, "password is hunter2"))
+	assert.Equal(t, "password is ********", out.Value.String())
+
+	out = ReplaceRedactedAttr(nil, slog.Any("error", errors.New("invalid password hunter2")))
+	assert.Equal(t, "invalid password ********", out.Value.String())
+
+	out = ReplaceRedactedAttr(nil, slog.Int("foo", 1))
+	assert.EqualValues(t, 1, out.Value.Int64())
+}
@@ -0,0 +1,82 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Placeholder replaces every occurrence of a sensitive value.
+const Placeholder = "********"
+
+var (
+	mu     sync.RWMutex
+	values = make(map[string]struct{})
+)
+
+// Register marks values as sensitive for the remainder of the process.
+// Every subsequent call to [String] or [JSON] replaces them with [Placeholder].
+// Empty values are ignored.
+func Register(vs ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, v := range vs {
+		if v == "" {
+			continue
+		}
+		values[v] = struct{}{}
+	}
+}
+
+// Reset clears all values marked as sensitive. Values are registered for the
+// remainder of the process, so tests use it to avoid affecting other tests.
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	values = make(map[string]struct{})
+}
+
+// sorted returns the registered values, longest first, such that a value that
+// contains another registered value is replaced in its entirety.
+func sorted() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	out := make([]string, 0, len(values))
+	for v := range values {
+		out = append(out, v)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if len(out[i]) != len(out[j]) {
+			return len(out[i]) > len(out[j])
+		}
+		return out[i] < out[j]
+	})
+	return out
+}
+
+// String replaces all sensitive values in s.
+func String(s string) string {
+	for _, v := range sorted() {
+		s = strings.ReplaceAll(s, v, Placeholder)
+	}
+	return s
+}
+
+// JSON replaces all sensitive values in a JSON document.
+//
+// Values are matched in their JSON-encoded form, so values that contain
+// characters that are escaped in JSON strings are redacted as well.
+func JSON(buf []byte) []byte {
+	for _, v := range sorted() {
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		// Strip the surrounding quotes.
+		encoded = encoded[1 : len(encoded)-1]
+		buf = bytes.ReplaceAll(buf, encoded, []byte(Placeholder))
+	}
+	return buf
+}
@@ -0,0 +1,42 @@
+package redact
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringWithoutRegisteredValues(t *testing.T) {
+	Reset()
+	assert.Equal(t, "foo bar", String("foo bar"))
+}
+
+func TestString(t *testing.T) {
+	Reset()
+	Register("s3cr3t", "")
+	assert.Equal(t, "token=******** again ********", String("token=s3cr3t again s3cr3t"))
+	assert.Equal(t, "", String(""))
+}
+
+func TestStringOverlappingValues(t *testing.T) {
+	Reset()
+	Register("abc", "abcdef")
+	assert.Equal(t, "******** and ********", String("abcdef and abc"))
+}
+
+func TestReset(t *testing.T) {
+	Reset()
+	Register("s3cr3t")
+	Reset()
+	assert.Equal(t, "token=s3cr3t", String("token=s3cr3t"))
+}
+
+func TestJSON(t *testing.T) {
+	Reset()
+	Register(`p"a\ss`)
+
+	buf, err := json.Marshal(map[string]string{"password": `p"a\ss`, "other": "value"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"password": "********", "other": "value"}`, string(JSON(buf)))
+}
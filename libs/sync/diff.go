@@ -78,7 +78,23 @@ func (d *diff) addNewFiles(after *SnapshotState, before *SnapshotState) {
 func (d *diff) addUpdatedFiles(after *SnapshotState, before *SnapshotState) {
 	for localName, modTime := range after.LastModifiedTimes {
 		prevModTime, ok := before.LastModifiedTimes[localName]
-		if ok && modTime.After(prevModTime) {
+		if !ok {
+			continue
+		}
+
+		// Compare contents if both states have a content hash for the file.
+		// This skips files that were rewritten without changes and catches
+		// changes that did not advance the mtime.
+		hash, ok := after.ContentHashes[localName]
+		prevHash, prevOk := before.ContentHashes[localName]
+		if ok && prevOk {
+			if hash != prevHash {
+				d.put = append(d.put, filepath.ToSlash(localName))
+			}
+			continue
+		}
+
+		if modTime.After(prevModTime) {
 			d.put = append(d.put, filepath.ToSlash(localName))
 		}
 	}
@@ -3,6 +3,8 @@ package sync
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 )
@@ -63,9 +65,34 @@ func (e *EventChanges) String() string {
 type EventStart struct {
 	*EventBase
 	*EventChanges
+
+	// Set if the changes are not applied because this is a dry run.
+	DryRun bool `json:"dry_run,omitempty"`
+
+	// Remote directories to create and remove, in the order they are processed.
+	// Only included for dry runs.
+	Mkdir []string `json:"mkdir,omitempty"`
+	Rmdir []string `json:"rmdir,omitempty"`
 }
 
 func (e *EventStart) String() string {
+	if e.DryRun {
+		changes := []string{}
+		if !e.IsEmpty() {
+			changes = append(changes, e.EventChanges.String())
+		}
+		if len(e.Mkdir) > 0 {
+			changes = append(changes, fmt.Sprintf("MKDIR: %s", strings.Join(e.Mkdir, ", ")))
+		}
+		if len(e.Rmdir) > 0 {
+			changes = append(changes, fmt.Sprintf("RMDIR: %s", strings.Join(e.Rmdir, ", ")))
+		}
+		if len(changes) == 0 {
+			return "Dry run: no changes"
+		}
+		return fmt.Sprintf("Dry run: %s", strings.Join(changes, ", "))
+	}
+
 	if e.IsEmpty() {
 		return ""
 	}
@@ -80,6 +107,34 @@ func newEventStart(seq int, put []string, delete []string) Event {
 	}
 }
 
+// newEventDryRun returns a start event for the changes in a diff that is not applied.
+// Files are sorted by name and directories are grouped like they are processed.
+func newEventDryRun(seq int, d diff) Event {
+	put := slices.Clone(d.put)
+	sort.Strings(put)
+	delete := slices.Clone(d.delete)
+	sort.Strings(delete)
+
+	var mkdir []string
+	for _, group := range d.groupedMkdir() {
+		sort.Strings(group)
+		mkdir = append(mkdir, group...)
+	}
+	var rmdir []string
+	for _, group := range d.groupedRmdir() {
+		sort.Strings(group)
+		rmdir = append(rmdir, group...)
+	}
+
+	return &EventStart{
+		EventBase:    newEventBase(seq, EventTypeStart),
+		EventChanges: &EventChanges{Put: put, Delete: delete},
+		DryRun:       true,
+		Mkdir:        mkdir,
+		Rmdir:        rmdir,
+	}
+}
+
 type EventSyncProgress struct {
 	*EventBase
 
@@ -57,6 +57,23 @@ func TestEventStartJSON(t *testing.T) {
 	jsonEqual(t, `{"seq": 3, "type": "start"}`, e)
 }
 
+func TestEventDryRun(t *testing.T) {
+	d := diff{
+		put:    []string{"b/c.py", "a.py"},
+		delete: []string{"x/y"},
+		mkdir:  []string{"b"},
+		rmdir:  []string{"x"},
+	}
+
+	e := newEventDryRun(0, d)
+	assert.Equal(t, "Dry run: PUT: a.py, b/c.py, DELETE: x/y, MKDIR: b, RMDIR: x", e.String())
+	jsonEqual(t, `{"seq": 0, "type": "start", "dry_run": true, "put": ["a.py", "b/c.py"], "delete": ["x/y"], "mkdir": ["b"], "rmdir": ["x"]}`, e)
+
+	e = newEventDryRun(0, diff{})
+	assert.Equal(t, "Dry run: no changes", e.String())
+	jsonEqual(t, `{"seq": 0, "type": "start", "dry_run": true}`, e)
+}
+
 func TestEventProgress(t *testing.T) {
 	var e Event
 
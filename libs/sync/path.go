@@ -2,10 +2,13 @@ package sync
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"path"
 	"strings"
 
+	"github.com/databricks/cli/libs/filer"
 	"github.com/databricks/cli/libs/log"
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/apierr"
@@ -25,6 +28,12 @@ func repoPathForPath(me *iam.User, remotePath string) string {
 // EnsureRemotePathIsUsable checks if the specified path is nested under
 // expected base paths and if it is a directory or repository.
 func EnsureRemotePathIsUsable(ctx context.Context, wsc *databricks.WorkspaceClient, remotePath string, me *iam.User) error {
+	return ensureRemotePathIsUsable(ctx, wsc, remotePath, me, true)
+}
+
+// ensureRemotePathIsUsable is [EnsureRemotePathIsUsable], but only creates
+// the remote path if it does not exist and create is set.
+func ensureRemotePathIsUsable(ctx context.Context, wsc *databricks.WorkspaceClient, remotePath string, me *iam.User, create bool) error {
 	var err error
 
 	// TODO: we should cache CurrentUser.Me at the SDK level
@@ -55,6 +64,11 @@ func EnsureRemotePathIsUsable(ctx context.Context, wsc *databricks.WorkspaceClie
 			}
 		}
 
+		if !create {
+			log.Debugf(ctx, "Path %s does not exist", remotePath)
+			return nil
+		}
+
 		// The workspace path doesn't exist. Create it and try again.
 		err = wsc.Workspace.MkdirsByPath(ctx, remotePath)
 		if err != nil {
@@ -84,3 +98,57 @@ func EnsureRemotePathIsUsable(ctx context.Context, wsc *databricks.WorkspaceClie
 
 	return fmt.Errorf("%s points to a %s", remotePath, strings.ToLower(info.ObjectType.String()))
 }
+
+// Type of storage that a remote path refers to.
+type remotePathType int
+
+const (
+	// Path in the workspace, e.g. "/Users/jane@doe.com/project".
+	remotePathWorkspace remotePathType = iota
+
+	// Path in DBFS, e.g. "dbfs:/tmp/project".
+	remotePathDbfs
+
+	// Path in a Unity Catalog volume, e.g. "/Volumes/main/default/files/project".
+	// The "dbfs:" scheme is optional.
+	remotePathVolume
+)
+
+// parseRemotePath returns the type of storage that a remote path refers to,
+// along with the path in that storage (without the "dbfs:" scheme).
+func parseRemotePath(remotePath string) (remotePathType, string) {
+	if p, ok := strings.CutPrefix(remotePath, "dbfs:"); ok {
+		if strings.HasPrefix(p, "/Volumes/") {
+			return remotePathVolume, p
+		}
+		return remotePathDbfs, p
+	}
+	if strings.HasPrefix(remotePath, "/Volumes/") {
+		return remotePathVolume, remotePath
+	}
+	return remotePathWorkspace, remotePath
+}
+
+// ensureFilerPathIsUsable checks that the root of the filer is a directory, if it exists.
+// Directories are created as needed when files are written.
+func ensureFilerPathIsUsable(ctx context.Context, f filer.Filer, typ remotePathType, remotePath string) error {
+	// The Files API used for volumes cannot reliably tell a directory from a file,
+	// so we rely on writes to fail if the path points to a file.
+	if typ == remotePathVolume {
+		log.Debugf(ctx, "Skipping directory check for volume path %s", remotePath)
+		return nil
+	}
+
+	info, err := f.Stat(ctx, ".")
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Debugf(ctx, "Path %s does not exist", remotePath)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s points to a file", remotePath)
+	}
+	return nil
+}
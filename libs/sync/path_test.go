@@ -1,10 +1,17 @@
 package sync
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/qa"
 	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPathToRepoPath(t *testing.T) {
@@ -22,3 +29,63 @@ func TestPathToRepoPath(t *testing.T) {
 	assert.Equal(t, "/Repos/hello@world.com/foo/bar/qux", repoPathForPath(&me, "/Repos/hello@world.com/foo/bar/qux"))
 	assert.Equal(t, "/Repos/hello@world.com/foo/bar/qux", repoPathForPath(&me, "/Repos/hello@world.com/foo/bar/qux/."))
 }
+
+func TestParseRemotePath(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		typ   remotePathType
+		path  string
+	}{
+		{"/Users/jane@doe.com/foo", remotePathWorkspace, "/Users/jane@doe.com/foo"},
+		{"/Repos/jane@doe.com/foo", remotePathWorkspace, "/Repos/jane@doe.com/foo"},
+		{"dbfs:/tmp/foo", remotePathDbfs, "/tmp/foo"},
+		{"/Volumes/main/default/files/foo", remotePathVolume, "/Volumes/main/default/files/foo"},
+		{"dbfs:/Volumes/main/default/files/foo", remotePathVolume, "/Volumes/main/default/files/foo"},
+	} {
+		typ, path := parseRemotePath(tc.input)
+		assert.Equal(t, tc.typ, typ, tc.input)
+		assert.Equal(t, tc.path, path, tc.input)
+	}
+}
+
+func TestEnsureFilerPathIsUsable(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// The path does not exist yet.
+	f, err := filer.NewLocalClient(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.NoError(t, ensureFilerPathIsUsable(ctx, f, remotePathDbfs, "dbfs:/missing"))
+
+	// The path is a directory.
+	f, err = filer.NewLocalClient(dir)
+	require.NoError(t, err)
+	assert.NoError(t, ensureFilerPathIsUsable(ctx, f, remotePathDbfs, "dbfs:/dir"))
+
+	// The path is a file.
+	err = os.WriteFile(filepath.Join(dir, "file"), []byte("hello"), 0644)
+	require.NoError(t, err)
+	f, err = filer.NewLocalClient(filepath.Join(dir, "file"))
+	require.NoError(t, err)
+	assert.EqualError(t, ensureFilerPathIsUsable(ctx, f, remotePathDbfs, "dbfs:/file"), "dbfs:/file points to a file")
+}
+
+func TestEnsureFilerPathIsUsableVolume(t *testing.T) {
+	// The Files API reports that a directory exists as if it were a file.
+	cfg, server := qa.HTTPFixtures{
+		{
+			Method:   "HEAD",
+			Resource: "/api/2.0/fs/files/Volumes%2Fmain%2Fdefault%2Ffiles%2Fproject",
+			Status:   200,
+		},
+	}.Config(t)
+	t.Cleanup(server.Close)
+
+	w, err := databricks.NewWorkspaceClient((*databricks.Config)(cfg))
+	require.NoError(t, err)
+	f, err := filer.NewFilesClient(w, "/Volumes/main/default/files/project")
+	require.NoError(t, err)
+
+	err = ensureFilerPathIsUsable(context.Background(), f, remotePathVolume, "/Volumes/main/default/files/project")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,476 @@
+package sync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/databricks/cli/libs/fileset"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+)
+
+// ConflictStrategy determines how files that were changed both locally
+// and remotely since the last sync are synchronized in pull mode.
+type ConflictStrategy string
+
+const (
+	// Abort the sync without changing any files. This is the default.
+	ConflictAbort = ConflictStrategy("abort")
+
+	// Keep the local changes and overwrite the remote changes.
+	ConflictPreferLocal = ConflictStrategy("prefer-local")
+
+	// Keep the remote changes and overwrite the local changes.
+	ConflictPreferRemote = ConflictStrategy("prefer-remote")
+)
+
+type changeKind string
+
+const (
+	changeAdded    = changeKind("added")
+	changeModified = changeKind("modified")
+	changeDeleted  = changeKind("deleted")
+)
+
+// Conflict describes a file that was changed both locally and remotely since the last sync.
+type Conflict struct {
+	// Local name of the file, separated by forward slashes.
+	Path string `json:"path"`
+
+	// How the file was changed locally and remotely (added, modified, or deleted).
+	Local  string `json:"local"`
+	Remote string `json:"remote"`
+}
+
+// ConflictError is returned if files were changed both locally and remotely
+// since the last sync and the conflict strategy is to abort.
+type ConflictError struct {
+	Conflicts []Conflict
+}
+
+func (e *ConflictError) Error() string {
+	var b strings.Builder
+	if len(e.Conflicts) == 1 {
+		b.WriteString("1 file was changed both locally and remotely since the last sync:")
+	} else {
+		fmt.Fprintf(&b, "%d files were changed both locally and remotely since the last sync:", len(e.Conflicts))
+	}
+	for _, c := range e.Conflicts {
+		fmt.Fprintf(&b, "\n  %s (%s locally, %s remotely)", c.Path, c.Local, c.Remote)
+	}
+	return b.String()
+}
+
+// remoteFile is a file in the remote path.
+type remoteFile struct {
+	// Name of the file relative to the remote path, separated by forward slashes.
+	name string
+
+	modTime time.Time
+
+	// Language of the file if it is a notebook.
+	language workspace.Language
+}
+
+// remoteChange is a change to a remote file since the last sync.
+type remoteChange struct {
+	remoteName string
+	localName  string
+	kind       changeKind
+	file       remoteFile
+}
+
+// listRemote returns all files in the remote path, keyed by their name.
+func (s *Sync) listRemote(ctx context.Context) (map[string]remoteFile, error) {
+	out := make(map[string]remoteFile)
+	queue := []string{"."}
+	for len(queue) > 0 {
+		dir := queue[0]
+		queue = queue[1:]
+
+		entries, err := s.filer.ReadDir(ctx, dir)
+		if err != nil {
+			// The remote path does not exist before the first sync.
+			if dir == "." && errors.Is(err, fs.ErrNotExist) {
+				return out, nil
+			}
+			return nil, fmt.Errorf("cannot list remote directory %s: %w", dir, err)
+		}
+
+		for _, entry := range entries {
+			name := path.Join(dir, entry.Name())
+			if entry.IsDir() {
+				queue = append(queue, name)
+				continue
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return nil, err
+			}
+
+			f := remoteFile{name: name, modTime: info.ModTime()}
+			if oi, ok := info.Sys().(workspace.ObjectInfo); ok && oi.ObjectType == workspace.ObjectTypeNotebook {
+				f.language = oi.Language
+			}
+			out[name] = f
+		}
+	}
+	return out, nil
+}
+
+// localNameForRemote returns the local name of a remote file that is not tracked yet.
+// Notebooks are stored in their source format with the extension for their language.
+func localNameForRemote(f remoteFile) string {
+	name := f.name
+	switch f.language {
+	case workspace.LanguagePython:
+		name += ".py"
+	case workspace.LanguageSql:
+		name += ".sql"
+	case workspace.LanguageScala:
+		name += ".scala"
+	case workspace.LanguageR:
+		name += ".r"
+	}
+	return filepath.FromSlash(name)
+}
+
+// localChanges returns the changes to local files since the last sync, keyed by remote name,
+// along with the state of the local files. The snapshot itself is left unchanged.
+func (s *Snapshot) localChanges(ctx context.Context, all []fileset.File) (map[string]changeKind, *SnapshotState, error) {
+	targetState, err := newSnapshotState(all, s.plainFiles)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while computing new sync state: %w", err)
+	}
+
+	currentState := s.SnapshotState
+	if err := currentState.validate(); err != nil {
+		return nil, nil, fmt.Errorf("error parsing existing sync state. Please delete your existing sync snapshot file (%s) and retry: %w", s.SnapshotPath, err)
+	}
+
+	if s.contentHash {
+		err := targetState.updateContentHashes(all, currentState)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	d := computeDiff(targetState, currentState)
+	changes := make(map[string]changeKind)
+	for _, name := range d.put {
+		localName := filepath.FromSlash(name)
+		kind := changeModified
+		if _, ok := currentState.LocalToRemoteNames[localName]; !ok {
+			kind = changeAdded
+		}
+		changes[targetState.LocalToRemoteNames[localName]] = kind
+	}
+	for _, remoteName := range d.delete {
+		if _, ok := changes[remoteName]; !ok {
+			changes[remoteName] = changeDeleted
+		}
+	}
+	return changes, targetState, nil
+}
+
+// pull downloads changes to remote files since the last sync to the local path.
+func (s *Sync) pull(ctx context.Context) error {
+	remote, err := s.listRemote(ctx)
+	if err != nil {
+		return err
+	}
+
+	files, err := getFileList(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	local, targetState, err := s.snapshot.localChanges(ctx, files)
+	if err != nil {
+		return err
+	}
+
+	state := s.snapshot.SnapshotState
+	if state.RemoteModifiedTimes == nil {
+		state.RemoteModifiedTimes = make(map[string]time.Time)
+	}
+
+	// Whether the snapshot must be saved.
+	dirty := false
+
+	// Find changes to files that are tracked.
+	var changes []remoteChange
+	for remoteName, localName := range state.RemoteToLocalNames {
+		f, exists := remote[remoteName]
+		modTime, ok := state.RemoteModifiedTimes[remoteName]
+		switch {
+		case !ok && exists:
+			// Files uploaded outside of pull mode have no recorded remote modified time.
+			// They are assumed to be unchanged remotely from here on.
+			state.RemoteModifiedTimes[remoteName] = f.modTime
+			dirty = true
+		case !ok:
+			// Without a recorded remote modified time we cannot tell if the file
+			// was deleted remotely on purpose. Forget it such that it is uploaded again.
+			state.remove(localName)
+			dirty = true
+		case !exists:
+			changes = append(changes, remoteChange{remoteName, localName, changeDeleted, f})
+		case !f.modTime.Equal(modTime):
+			changes = append(changes, remoteChange{remoteName, localName, changeModified, f})
+		}
+	}
+
+	// Find files that are not tracked yet.
+	for remoteName, f := range remote {
+		if _, ok := state.RemoteToLocalNames[remoteName]; ok {
+			continue
+		}
+
+		// A local file that is not tracked yet may already map to this remote name.
+		localName, ok := targetState.RemoteToLocalNames[remoteName]
+		if !ok {
+			localName = localNameForRemote(f)
+			existing, ok := state.LocalToRemoteNames[localName]
+			if !ok {
+				existing, ok = targetState.LocalToRemoteNames[localName]
+			}
+			if ok {
+				log.Warnf(ctx, "Not downloading %s because local file %s is synchronized to %s", remoteName, filepath.ToSlash(localName), existing)
+				continue
+			}
+		}
+
+		include, err := s.includesFile(localName)
+		if err != nil {
+			return err
+		}
+		if !include {
+			continue
+		}
+		changes = append(changes, remoteChange{remoteName, localName, changeAdded, f})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].remoteName < changes[j].remoteName
+	})
+
+	var pulls []remoteChange
+	var conflicts []Conflict
+	for _, c := range changes {
+		kind, ok := local[c.remoteName]
+		if !ok {
+			pulls = append(pulls, c)
+			continue
+		}
+		if kind == changeDeleted && c.kind == changeDeleted {
+			// Deleted on both sides; there is nothing left to synchronize.
+			state.remove(c.localName)
+			dirty = true
+			continue
+		}
+		if kind == changeAdded && c.kind == changeAdded {
+			// Files that exist on both sides without a record in the snapshot, for example
+			// after a fresh clone or a full sync, are only a conflict if their contents differ.
+			same, err := s.sameContents(ctx, c)
+			if err != nil {
+				return err
+			}
+			if same {
+				err = s.trackFile(c)
+				if err != nil {
+					return err
+				}
+				dirty = true
+				continue
+			}
+		}
+		conflicts = append(conflicts, Conflict{
+			Path:   filepath.ToSlash(c.localName),
+			Local:  string(kind),
+			Remote: string(c.kind),
+		})
+		if s.Conflicts == ConflictPreferRemote {
+			pulls = append(pulls, c)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		switch s.Conflicts {
+		case ConflictPreferLocal:
+			log.Infof(ctx, "Keeping local changes to %d file(s) that were also changed remotely", len(conflicts))
+		case ConflictPreferRemote:
+			log.Infof(ctx, "Keeping remote changes to %d file(s) that were also changed locally", len(conflicts))
+		default:
+			return &ConflictError{Conflicts: conflicts}
+		}
+	}
+
+	for _, c := range pulls {
+		err := s.pullFile(ctx, c)
+		if err != nil {
+			return err
+		}
+		dirty = true
+	}
+
+	if !dirty {
+		return nil
+	}
+
+	err = s.snapshot.Save(ctx)
+	if err != nil {
+		log.Errorf(ctx, "cannot store snapshot: %s", err)
+		return err
+	}
+	return nil
+}
+
+// pullFile applies a change to a remote file to the local path and updates the
+// snapshot state, such that the local file is not considered changed by the next diff.
+func (s *Sync) pullFile(ctx context.Context, c remoteChange) error {
+	state := s.snapshot.SnapshotState
+	localPath := filepath.Join(s.LocalPath, c.localName)
+
+	if c.kind == changeDeleted {
+		log.Infof(ctx, "Removing %s because it was deleted remotely", filepath.ToSlash(c.localName))
+		err := os.Remove(localPath)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		state.remove(c.localName)
+		return nil
+	}
+
+	log.Infof(ctx, "Downloading %s", filepath.ToSlash(c.localName))
+	err := s.download(ctx, c.remoteName, c.localName)
+	if err != nil {
+		return fmt.Errorf("cannot download %s: %w", c.remoteName, err)
+	}
+
+	return s.trackFile(c)
+}
+
+// trackFile records the local file for a remote file in the snapshot state,
+// along with the modified time of the remote file.
+func (s *Sync) trackFile(c remoteChange) error {
+	state := s.snapshot.SnapshotState
+	localPath := filepath.Join(s.LocalPath, c.localName)
+	info, err := os.Lstat(localPath)
+	if err != nil {
+		return err
+	}
+
+	state.remove(c.localName)
+	err = state.add(fileset.File{
+		DirEntry: fs.FileInfoToDirEntry(info),
+		Absolute: localPath,
+		Relative: c.localName,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The local file may map to a different remote name if its notebook
+	// type could not be determined; it is then uploaded by the next diff.
+	if state.LocalToRemoteNames[c.localName] == c.remoteName {
+		state.RemoteModifiedTimes[c.remoteName] = c.file.modTime
+	}
+	return nil
+}
+
+// readRemote returns the contents of a remote file in the format of the local file.
+// Notebooks are exported in their source format, unless the local file is a Jupyter notebook.
+func (s *Sync) readRemote(ctx context.Context, remoteName string, localName string) (io.ReadCloser, error) {
+	if !s.snapshot.plainFiles && strings.ToLower(filepath.Ext(localName)) == ".ipynb" && s.WorkspaceClient != nil {
+		return s.WorkspaceClient.Workspace.Download(ctx,
+			path.Join(s.RemotePath, remoteName),
+			workspace.DownloadFormat(workspace.ExportFormatJupyter),
+		)
+	}
+	return s.filer.Read(ctx, remoteName)
+}
+
+// sameContents returns true if the local and remote file of a change have the same contents.
+func (s *Sync) sameContents(ctx context.Context, c remoteChange) (bool, error) {
+	localHash, err := contentHash(filepath.Join(s.LocalPath, c.localName))
+	if err != nil {
+		return false, err
+	}
+
+	r, err := s.readRemote(ctx, c.remoteName, c.localName)
+	if err != nil {
+		return false, fmt.Errorf("cannot read %s: %w", c.remoteName, err)
+	}
+	defer r.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, r)
+	if err != nil {
+		return false, fmt.Errorf("cannot read %s: %w", c.remoteName, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)) == localHash, nil
+}
+
+// download writes the contents of a remote file to the local path.
+func (s *Sync) download(ctx context.Context, remoteName string, localName string) error {
+	r, err := s.readRemote(ctx, remoteName, localName)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	localPath := filepath.Join(s.LocalPath, localName)
+	err = os.MkdirAll(filepath.Dir(localPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// recordRemoteModifiedTimes records the remote modified times of tracked files
+// for which none is recorded, for example because they were just uploaded.
+func (s *Sync) recordRemoteModifiedTimes(ctx context.Context) error {
+	remote, err := s.listRemote(ctx)
+	if err != nil {
+		return err
+	}
+
+	state := s.snapshot.SnapshotState
+	for remoteName := range state.RemoteToLocalNames {
+		if _, ok := state.RemoteModifiedTimes[remoteName]; ok {
+			continue
+		}
+		if f, ok := remote[remoteName]; ok {
+			state.RemoteModifiedTimes[remoteName] = f.modTime
+		}
+	}
+
+	err = s.snapshot.Save(ctx)
+	if err != nil {
+		log.Errorf(ctx, "cannot store snapshot: %s", err)
+		return err
+	}
+	return nil
+}
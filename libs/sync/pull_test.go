@@ -0,0 +1,176 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPullSync returns a sync in pull mode between two temporary directories,
+// after an initial sync of a single file "a.txt".
+func newTestPullSync(t *testing.T, conflicts ConflictStrategy) (s *Sync, localDir string, remoteDir string) {
+	localDir = t.TempDir()
+	remoteDir = t.TempDir()
+	writeTestFile(t, localDir, "a.txt", "local", time.Now().Add(-time.Hour))
+
+	s = newTestSync(t, localDir, nil, nil)
+	remote, err := filer.NewLocalClient(remoteDir)
+	require.NoError(t, err)
+	s.filer = remote
+	s.Pull = true
+	s.Conflicts = conflicts
+
+	err = s.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Contains(t, s.snapshot.RemoteModifiedTimes, "a.txt")
+	return s, localDir, remoteDir
+}
+
+func writeTestFile(t *testing.T, dir string, name string, content string, modTime time.Time) {
+	path := filepath.Join(dir, name)
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	require.NoError(t, err)
+	err = os.WriteFile(path, []byte(content), 0644)
+	require.NoError(t, err)
+	err = os.Chtimes(path, modTime, modTime)
+	require.NoError(t, err)
+}
+
+func readTestFile(t *testing.T, dir string, name string) string {
+	buf, err := os.ReadFile(filepath.Join(dir, name))
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func TestPullDownloadsRemoteChanges(t *testing.T) {
+	ctx := context.Background()
+	s, localDir, remoteDir := newTestPullSync(t, ConflictAbort)
+	assert.Equal(t, "local", readTestFile(t, remoteDir, "a.txt"))
+
+	// Modify a file and add a file remotely.
+	writeTestFile(t, remoteDir, "a.txt", "remote", time.Now().Add(time.Minute))
+	writeTestFile(t, remoteDir, "dir/b.txt", "new", time.Now())
+
+	err := s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "remote", readTestFile(t, localDir, "a.txt"))
+	assert.Equal(t, "new", readTestFile(t, localDir, "dir/b.txt"))
+	assert.Contains(t, s.snapshot.LocalToRemoteNames, filepath.Join("dir", "b.txt"))
+	assert.Contains(t, s.snapshot.RemoteModifiedTimes, "dir/b.txt")
+
+	// Delete a file remotely.
+	err = os.Remove(filepath.Join(remoteDir, "dir", "b.txt"))
+	require.NoError(t, err)
+
+	err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.NoFileExists(t, filepath.Join(localDir, "dir", "b.txt"))
+	assert.NotContains(t, s.snapshot.LocalToRemoteNames, filepath.Join("dir", "b.txt"))
+
+	// Local changes are uploaded and not mistaken for remote changes afterwards.
+	writeTestFile(t, localDir, "a.txt", "local again", time.Now().Add(2*time.Minute))
+	err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "local again", readTestFile(t, remoteDir, "a.txt"))
+
+	err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "local again", readTestFile(t, localDir, "a.txt"))
+}
+
+func TestPullConflicts(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		strategy ConflictStrategy
+		expected string
+	}{
+		{ConflictAbort, ""},
+		{ConflictPreferLocal, "local change"},
+		{ConflictPreferRemote, "remote change"},
+	} {
+		t.Run(string(tc.strategy), func(t *testing.T) {
+			s, localDir, remoteDir := newTestPullSync(t, tc.strategy)
+
+			// Modify the file on both sides.
+			writeTestFile(t, localDir, "a.txt", "local change", time.Now().Add(time.Minute))
+			writeTestFile(t, remoteDir, "a.txt", "remote change", time.Now().Add(time.Minute))
+
+			err := s.RunOnce(ctx)
+			if tc.strategy == ConflictAbort {
+				var cerr *ConflictError
+				require.ErrorAs(t, err, &cerr)
+				assert.Equal(t, []Conflict{{Path: "a.txt", Local: "modified", Remote: "modified"}}, cerr.Conflicts)
+				assert.ErrorContains(t, err, "a.txt (modified locally, modified remotely)")
+
+				// Neither side is changed.
+				assert.Equal(t, "local change", readTestFile(t, localDir, "a.txt"))
+				assert.Equal(t, "remote change", readTestFile(t, remoteDir, "a.txt"))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, readTestFile(t, localDir, "a.txt"))
+			assert.Equal(t, tc.expected, readTestFile(t, remoteDir, "a.txt"))
+		})
+	}
+}
+
+func TestPullDeletedOnBothSides(t *testing.T) {
+	ctx := context.Background()
+	s, localDir, remoteDir := newTestPullSync(t, ConflictAbort)
+
+	err := os.Remove(filepath.Join(localDir, "a.txt"))
+	require.NoError(t, err)
+	err = os.Remove(filepath.Join(remoteDir, "a.txt"))
+	require.NoError(t, err)
+
+	err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, s.snapshot.LocalToRemoteNames, "a.txt")
+}
+
+func TestLocalNameForRemote(t *testing.T) {
+	assert.Equal(t, "a.txt", localNameForRemote(remoteFile{name: "a.txt"}))
+	assert.Equal(t, filepath.Join("dir", "nb.py"), localNameForRemote(remoteFile{name: "dir/nb", language: "PYTHON"}))
+	assert.Equal(t, "nb.sql", localNameForRemote(remoteFile{name: "nb", language: "SQL"}))
+}
+
+func TestPullAddedOnBothSides(t *testing.T) {
+	ctx := context.Background()
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+	writeTestFile(t, localDir, "a.txt", "same", time.Now().Add(-time.Hour))
+	writeTestFile(t, remoteDir, "a.txt", "same", time.Now().Add(-time.Hour))
+	writeTestFile(t, localDir, "b.txt", "local", time.Now().Add(-time.Hour))
+	writeTestFile(t, remoteDir, "b.txt", "remote", time.Now().Add(-time.Hour))
+
+	// The snapshot is fresh, so neither file is tracked yet.
+	s := newTestSync(t, localDir, nil, nil)
+	remote, err := filer.NewLocalClient(remoteDir)
+	require.NoError(t, err)
+	s.filer = remote
+	s.Pull = true
+
+	// Only the file with different contents is a conflict.
+	err = s.RunOnce(ctx)
+	var cerr *ConflictError
+	require.ErrorAs(t, err, &cerr)
+	assert.Equal(t, []Conflict{{Path: "b.txt", Local: "added", Remote: "added"}}, cerr.Conflicts)
+
+	// Files with the same contents are tracked without being transferred.
+	writeTestFile(t, remoteDir, "b.txt", "local", time.Now().Add(-time.Hour))
+	err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, s.snapshot.LocalToRemoteNames, "a.txt")
+	assert.Contains(t, s.snapshot.RemoteModifiedTimes, "a.txt")
+	assert.Contains(t, s.snapshot.RemoteModifiedTimes, "b.txt")
+	assert.Equal(t, "same", readTestFile(t, localDir, "a.txt"))
+	assert.Equal(t, "local", readTestFile(t, remoteDir, "b.txt"))
+}
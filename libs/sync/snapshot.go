@@ -16,7 +16,21 @@ import (
 )
 
 // Bump it up every time a potentially breaking change is made to the snapshot schema
-const LatestSnapshotVersion = "v1"
+const LatestSnapshotVersion = "v3"
+
+// Migrations of snapshots from previous schema versions, keyed by the version they
+// migrate from. Each migration updates the version of the snapshot it migrates.
+// Snapshots with a version that cannot be migrated are invalidated.
+var snapshotMigrations = map[string]func(s *Snapshot){
+	// Version v2 adds content hashes. They are recorded on the next sync, if enabled.
+	"v1": func(s *Snapshot) {
+		s.Version = "v2"
+	},
+	// Version v3 adds remote modified times. They are recorded on the next sync in pull mode.
+	"v2": func(s *Snapshot) {
+		s.Version = "v3"
+	},
+}
 
 // A snapshot is a persistant store of knowledge this CLI has about state of files
 // in the remote repo. We use the last modified times (mtime) of files to determine
@@ -49,6 +63,9 @@ type Snapshot struct {
 	RemotePath string `json:"remote_path"`
 
 	*SnapshotState
+
+	// Record content hashes of files when computing a new state.
+	contentHash bool
 }
 
 const syncSnapshotDirName = "sync-snapshots"
@@ -88,10 +105,15 @@ func newSnapshot(ctx context.Context, opts *SyncOptions) (*Snapshot, error) {
 		Host:       opts.Host,
 		RemotePath: opts.RemotePath,
 		SnapshotState: &SnapshotState{
-			LastModifiedTimes:  make(map[string]time.Time),
-			LocalToRemoteNames: make(map[string]string),
-			RemoteToLocalNames: make(map[string]string),
+			LastModifiedTimes:   make(map[string]time.Time),
+			LocalToRemoteNames:  make(map[string]string),
+			RemoteToLocalNames:  make(map[string]string),
+			ContentHashes:       make(map[string]string),
+			RemoteModifiedTimes: make(map[string]time.Time),
+			plainFiles:          plainFiles(opts),
 		},
+
+		contentHash: opts.ContentHash,
 	}, nil
 }
 
@@ -138,15 +160,20 @@ func loadOrNewSnapshot(ctx context.Context, opts *SyncOptions) (*Snapshot, error
 		return nil, fmt.Errorf("failed to read sync snapshot from disk: %s", err)
 	}
 
-	var fromDisk Snapshot
+	// Only decode the version first; snapshots with a different schema may not
+	// decode into the current snapshot structure.
+	var fromDisk struct {
+		Version string `json:"version"`
+	}
 	err = json.Unmarshal(bytes, &fromDisk)
 	if err != nil {
 		return nil, fmt.Errorf("failed to json unmarshal persisted snapshot: %s", err)
 	}
 
-	// invalidate old snapshot with schema versions
-	if fromDisk.Version != LatestSnapshotVersion {
-		log.Warnf(ctx, "Did not load existing snapshot because its version is %s while the latest version is %s", snapshot.Version, LatestSnapshotVersion)
+	// invalidate snapshots with a schema version that cannot be migrated, including
+	// snapshots without a version and snapshots written by a newer version of the CLI
+	if _, ok := snapshotMigrations[fromDisk.Version]; !ok && fromDisk.Version != LatestSnapshotVersion {
+		log.Warnf(ctx, "Did not load existing snapshot because its version is %s while the latest version is %s", fromDisk.Version, LatestSnapshotVersion)
 		return newSnapshot(ctx, opts)
 	}
 
@@ -156,12 +183,39 @@ func loadOrNewSnapshot(ctx context.Context, opts *SyncOptions) (*Snapshot, error
 		return nil, fmt.Errorf("failed to json unmarshal persisted snapshot: %s", err)
 	}
 
+	// migrate snapshots with older schema versions
+	for snapshot.Version != LatestSnapshotVersion {
+		version := snapshot.Version
+		migrate, ok := snapshotMigrations[version]
+		if !ok {
+			log.Warnf(ctx, "Did not load existing snapshot because its version is %s while the latest version is %s", version, LatestSnapshotVersion)
+			return newSnapshot(ctx, opts)
+		}
+		migrate(snapshot)
+		log.Debugf(ctx, "Migrated snapshot from version %s to %s", version, snapshot.Version)
+	}
+
+	// Snapshots of previous versions do not include content hashes or remote modified times.
+	if snapshot.ContentHashes == nil {
+		snapshot.ContentHashes = make(map[string]string)
+	}
+	if snapshot.RemoteModifiedTimes == nil {
+		snapshot.RemoteModifiedTimes = make(map[string]time.Time)
+	}
+
+	snapshot.plainFiles = plainFiles(opts)
 	snapshot.New = false
 	return snapshot, nil
 }
 
+// plainFiles returns true if files are stored under their local names in the remote path.
+func plainFiles(opts *SyncOptions) bool {
+	typ, _ := parseRemotePath(opts.RemotePath)
+	return typ != remotePathWorkspace
+}
+
 func (s *Snapshot) diff(ctx context.Context, all []fileset.File) (diff, error) {
-	targetState, err := NewSnapshotState(all)
+	targetState, err := newSnapshotState(all, s.plainFiles)
 	if err != nil {
 		return diff{}, fmt.Errorf("error while computing new sync state: %w", err)
 	}
@@ -171,8 +225,57 @@ func (s *Snapshot) diff(ctx context.Context, all []fileset.File) (diff, error) {
 		return diff{}, fmt.Errorf("error parsing existing sync state. Please delete your existing sync snapshot file (%s) and retry: %w", s.SnapshotPath, err)
 	}
 
+	if s.contentHash {
+		err := targetState.updateContentHashes(all, currentState)
+		if err != nil {
+			return diff{}, err
+		}
+	} else {
+		targetState.ContentHashes = make(map[string]string)
+	}
+
+	// Compute diff to apply to get from current state to new target state.
+	diff := computeDiff(targetState, currentState)
+	targetState.keepRemoteModifiedTimes(currentState, diff)
+
+	// Update state to new value. This is not persisted to the file system before
+	// the diff is applied successfully.
+	s.SnapshotState = targetState
+	return diff, nil
+}
+
+// diffPaths computes the diff for changes to the specified local paths only; the state
+// of all other files is assumed to be unchanged. The files argument holds the files
+// among these paths that exist and are to be synchronized.
+func (s *Snapshot) diffPaths(ctx context.Context, paths []string, files []fileset.File) (diff, error) {
+	currentState := s.SnapshotState
+	if err := currentState.validate(); err != nil {
+		return diff{}, fmt.Errorf("error parsing existing sync state. Please delete your existing sync snapshot file (%s) and retry: %w", s.SnapshotPath, err)
+	}
+
+	targetState := currentState.clone()
+	for _, path := range paths {
+		targetState.remove(path)
+	}
+	for _, f := range files {
+		err := targetState.add(f)
+		if err != nil {
+			return diff{}, fmt.Errorf("error while computing new sync state: %w", err)
+		}
+	}
+
+	if s.contentHash {
+		err := targetState.updateContentHashes(files, currentState)
+		if err != nil {
+			return diff{}, err
+		}
+	} else {
+		targetState.ContentHashes = make(map[string]string)
+	}
+
 	// Compute diff to apply to get from current state to new target state.
 	diff := computeDiff(targetState, currentState)
+	targetState.keepRemoteModifiedTimes(currentState, diff)
 
 	// Update state to new value. This is not persisted to the file system before
 	// the diff is applied successfully.
@@ -1,13 +1,19 @@
 package sync
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/databricks/cli/libs/fileset"
 	"github.com/databricks/cli/libs/notebook"
+	"golang.org/x/exp/maps"
 )
 
 // SnapshotState keeps track of files on the local filesystem and their corresponding
@@ -25,14 +31,36 @@ type SnapshotState struct {
 	// Inverse of LocalToRemoteNames. Together they form a 1:1 mapping where all
 	// the remote names and local names are unique.
 	RemoteToLocalNames map[string]string `json:"remote_to_local_names"`
+
+	// Map of local file names to the SHA-256 hash of their contents. Only populated
+	// if content hashing is enabled. Files for which both the previous and the new
+	// state have a hash are synced if their hashes differ, regardless of mtimes.
+	ContentHashes map[string]string `json:"content_hashes,omitempty"`
+
+	// Map of remote file names to their modified time in WSFS as of the last sync in
+	// pull mode. Remote files found to have a different modified time were changed
+	// remotely since then. Entries are dropped for files that are changed by the sync.
+	RemoteModifiedTimes map[string]time.Time `json:"remote_modified_times,omitempty"`
+
+	// Set if remote names are the same as local names. Only the workspace stores
+	// notebooks without their extension; DBFS and volumes store plain files.
+	// This follows from the remote path and is therefore not persisted.
+	plainFiles bool
 }
 
 // Convert an array of files on the local file system to a SnapshotState representation.
 func NewSnapshotState(localFiles []fileset.File) (*SnapshotState, error) {
+	return newSnapshotState(localFiles, false)
+}
+
+func newSnapshotState(localFiles []fileset.File, plainFiles bool) (*SnapshotState, error) {
 	fs := &SnapshotState{
-		LastModifiedTimes:  make(map[string]time.Time),
-		LocalToRemoteNames: make(map[string]string),
-		RemoteToLocalNames: make(map[string]string),
+		LastModifiedTimes:   make(map[string]time.Time),
+		LocalToRemoteNames:  make(map[string]string),
+		RemoteToLocalNames:  make(map[string]string),
+		ContentHashes:       make(map[string]string),
+		RemoteModifiedTimes: make(map[string]time.Time),
+		plainFiles:          plainFiles,
 	}
 
 	// Expect no files to have a duplicate entry in the input array.
@@ -47,29 +75,135 @@ func NewSnapshotState(localFiles []fileset.File) (*SnapshotState, error) {
 
 	// Compute the new state.
 	for _, f := range localFiles {
-		// Compute the remote name the file will have in WSFS
-		remoteName := filepath.ToSlash(f.Relative)
+		err := fs.add(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return fs, nil
+}
+
+// add adds a local file to the state. Files whose notebook type
+// cannot be determined are skipped, unless the state is for plain files.
+func (fs *SnapshotState) add(f fileset.File) error {
+	// Compute the remote name the file will have in WSFS
+	remoteName := filepath.ToSlash(f.Relative)
+	if !fs.plainFiles {
 		isNotebook, _, err := notebook.Detect(f.Absolute)
 		if err != nil {
 			// Ignore this file if we're unable to determine the notebook type.
 			// Trying to upload such a file to the workspace would fail anyway.
-			continue
+			return nil
 		}
 		if isNotebook {
 			ext := filepath.Ext(remoteName)
 			remoteName = strings.TrimSuffix(remoteName, ext)
 		}
+	}
+
+	// Add the file to snapshot state
+	fs.LastModifiedTimes[f.Relative] = f.Modified()
+	if existingLocalName, ok := fs.RemoteToLocalNames[remoteName]; ok {
+		return fmt.Errorf("both %s and %s point to the same remote file location %s. Please remove one of them from your local project", existingLocalName, f.Relative, remoteName)
+	}
+
+	fs.LocalToRemoteNames[f.Relative] = remoteName
+	fs.RemoteToLocalNames[remoteName] = f.Relative
+	return nil
+}
+
+// remove removes a local file from the state, if present.
+func (fs *SnapshotState) remove(localName string) {
+	remoteName, ok := fs.LocalToRemoteNames[localName]
+	if !ok {
+		return
+	}
+	delete(fs.LastModifiedTimes, localName)
+	delete(fs.LocalToRemoteNames, localName)
+	delete(fs.RemoteToLocalNames, remoteName)
+	delete(fs.ContentHashes, localName)
+	delete(fs.RemoteModifiedTimes, remoteName)
+}
+
+// clone returns a copy of the state that can be modified independently.
+func (fs *SnapshotState) clone() *SnapshotState {
+	return &SnapshotState{
+		LastModifiedTimes:   maps.Clone(fs.LastModifiedTimes),
+		LocalToRemoteNames:  maps.Clone(fs.LocalToRemoteNames),
+		RemoteToLocalNames:  maps.Clone(fs.RemoteToLocalNames),
+		ContentHashes:       maps.Clone(fs.ContentHashes),
+		RemoteModifiedTimes: maps.Clone(fs.RemoteModifiedTimes),
+		plainFiles:          fs.plainFiles,
+	}
+}
+
+// keepRemoteModifiedTimes carries over the remote modified times recorded in the previous
+// state for files that the diff leaves untouched. Files that are put or deleted are changed
+// remotely by the sync itself, so their previously recorded modified times no longer apply.
+func (fs *SnapshotState) keepRemoteModifiedTimes(before *SnapshotState, d diff) {
+	changed := make(map[string]bool)
+	for _, remoteName := range d.delete {
+		changed[remoteName] = true
+	}
+	for _, localName := range d.put {
+		changed[fs.LocalToRemoteNames[filepath.FromSlash(localName)]] = true
+	}
+
+	fs.RemoteModifiedTimes = make(map[string]time.Time)
+	for remoteName, modTime := range before.RemoteModifiedTimes {
+		if _, ok := fs.RemoteToLocalNames[remoteName]; !ok || changed[remoteName] {
+			continue
+		}
+		fs.RemoteModifiedTimes[remoteName] = modTime
+	}
+}
+
+// updateContentHashes records the content hashes of the specified files, which must
+// be part of the state. To avoid reading every file on every sync, the hash recorded in
+// the previous state is reused for files whose mtime has not changed since then.
+func (fs *SnapshotState) updateContentHashes(files []fileset.File, before *SnapshotState) error {
+	if fs.ContentHashes == nil {
+		fs.ContentHashes = make(map[string]string)
+	}
+	for _, f := range files {
+		modTime, ok := fs.LastModifiedTimes[f.Relative]
+		if !ok {
+			continue
+		}
 
-		// Add the file to snapshot state
-		fs.LastModifiedTimes[f.Relative] = f.Modified()
-		if existingLocalName, ok := fs.RemoteToLocalNames[remoteName]; ok {
-			return nil, fmt.Errorf("both %s and %s point to the same remote file location %s. Please remove one of them from your local project", existingLocalName, f.Relative, remoteName)
+		prevHash, ok := before.ContentHashes[f.Relative]
+		if ok && modTime.Equal(before.LastModifiedTimes[f.Relative]) {
+			fs.ContentHashes[f.Relative] = prevHash
+			continue
 		}
 
-		fs.LocalToRemoteNames[f.Relative] = remoteName
-		fs.RemoteToLocalNames[remoteName] = f.Relative
+		hash, err := contentHash(f.Absolute)
+		if errors.Is(err, os.ErrNotExist) {
+			// The file was removed after it was listed; it is picked up by the next sync.
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("cannot compute content hash of %s: %w", f.Relative, err)
+		}
+		fs.ContentHashes[f.Relative] = hash
 	}
-	return fs, nil
+	return nil
+}
+
+// contentHash returns the hex-encoded SHA-256 hash of the contents of a file.
+func contentHash(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
 // Consistency checks for the sync files state representation. These are invariants
@@ -80,6 +214,8 @@ func NewSnapshotState(localFiles []fileset.File) (*SnapshotState, error) {
 //     and vice versa.
 //  2. LocalToRemoteNames and RemoteToLocalNames together form a 1:1 mapping of
 //     local <-> remote file names.
+//  3. All entries in ContentHashes have a corresponding entry in LocalToRemoteNames.
+//  4. All entries in RemoteModifiedTimes have a corresponding entry in RemoteToLocalNames.
 func (fs *SnapshotState) validate() error {
 	// Validate invariant (1)
 	for localName := range fs.LastModifiedTimes {
@@ -110,5 +246,19 @@ func (fs *SnapshotState) validate() error {
 			return fmt.Errorf("invalid sync state representation. Inconsistent values found. Remote file %s points to %s. Local file %s points to %s", remoteName, localName, localName, fs.LocalToRemoteNames[localName])
 		}
 	}
+
+	// Validate invariant (3)
+	for localName := range fs.ContentHashes {
+		if _, ok := fs.LocalToRemoteNames[localName]; !ok {
+			return fmt.Errorf("invalid sync state representation. Local file %s has a content hash but is missing the corresponding remote file", localName)
+		}
+	}
+
+	// Validate invariant (4)
+	for remoteName := range fs.RemoteModifiedTimes {
+		if _, ok := fs.RemoteToLocalNames[remoteName]; !ok {
+			return fmt.Errorf("invalid sync state representation. Remote file %s has a modified time but is missing the corresponding local file", remoteName)
+		}
+	}
 	return nil
 }
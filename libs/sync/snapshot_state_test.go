@@ -31,6 +31,20 @@ func TestSnapshotState(t *testing.T) {
 	assert.NoError(t, s.validate())
 }
 
+func TestSnapshotStatePlainFiles(t *testing.T) {
+	fileSet := fileset.New("./testdata/sync-fileset")
+	files, err := fileSet.All()
+	require.NoError(t, err)
+
+	// Files keep their local names and the invalid notebook is included.
+	s, err := newSnapshotState(files, true)
+	require.NoError(t, err)
+	assertKeysOfMap(t, s.LocalToRemoteNames, []string{"invalid-nb.ipynb", "valid-nb.ipynb", "my-nb.py", "my-script.py"})
+	assertKeysOfMap(t, s.RemoteToLocalNames, []string{"invalid-nb.ipynb", "valid-nb.ipynb", "my-nb.py", "my-script.py"})
+	assert.NoError(t, s.validate())
+	assert.True(t, s.clone().plainFiles)
+}
+
 func TestSnapshotStateValidationErrors(t *testing.T) {
 	s := &SnapshotState{
 		LastModifiedTimes: map[string]time.Time{
@@ -383,6 +383,30 @@ func TestNoVersionSnapshotInvalidation(t *testing.T) {
 	assert.True(t, snapshot.New)
 }
 
+func TestIncompatibleVersionSnapshotInvalidation(t *testing.T) {
+	// A snapshot written by a newer version of the CLI, with a different schema.
+	incompatibleSnapshot := `{
+		"version": "v99",
+		"host": "www.foobar.com",
+		"remote_path": "/Repos/foo/bar",
+		"last_modified_times": ["a.py"],
+		"local_to_remote_names": "none"
+	}`
+
+	opts := defaultOptions(t)
+	snapshotPath, err := SnapshotPath(opts)
+	require.NoError(t, err)
+	snapshotFile := testfile.CreateFile(t, snapshotPath)
+	snapshotFile.Overwrite(t, incompatibleSnapshot)
+	snapshotFile.Close(t)
+
+	// assert snapshot did not get loaded
+	snapshot, err := loadOrNewSnapshot(context.Background(), opts)
+	require.NoError(t, err)
+	assert.True(t, snapshot.New)
+	assert.Equal(t, LatestSnapshotVersion, snapshot.Version)
+}
+
 func TestLatestVersionSnapshotGetsLoaded(t *testing.T) {
 	latestVersionSnapshot := fmt.Sprintf(`{
 			"version": "%s",
@@ -408,3 +432,107 @@ func TestLatestVersionSnapshotGetsLoaded(t *testing.T) {
 	assert.Equal(t, "www.foobar.com", snapshot.Host)
 	assert.Equal(t, "/Repos/foo/bar", snapshot.RemotePath)
 }
+
+func TestV1SnapshotGetsMigrated(t *testing.T) {
+	v1Snapshot := `{
+		"version": "v1",
+		"host": "www.foobar.com",
+		"remote_path": "/Repos/foo/bar",
+		"last_modified_times": {"hello.txt": "2023-01-01T00:00:00Z"},
+		"local_to_remote_names": {"hello.txt": "hello.txt"},
+		"remote_to_local_names": {"hello.txt": "hello.txt"}
+	}`
+
+	opts := defaultOptions(t)
+	snapshotPath, err := SnapshotPath(opts)
+	require.NoError(t, err)
+	snapshotFile := testfile.CreateFile(t, snapshotPath)
+	snapshotFile.Overwrite(t, v1Snapshot)
+	snapshotFile.Close(t)
+
+	// assert snapshot gets loaded and migrated
+	snapshot, err := loadOrNewSnapshot(context.Background(), opts)
+	require.NoError(t, err)
+	assert.False(t, snapshot.New)
+	assert.Equal(t, LatestSnapshotVersion, snapshot.Version)
+	assert.Equal(t, map[string]string{"hello.txt": "hello.txt"}, snapshot.LocalToRemoteNames)
+	assert.NotNil(t, snapshot.ContentHashes)
+	assert.Empty(t, snapshot.ContentHashes)
+}
+
+func TestContentHashDiff(t *testing.T) {
+	ctx := context.Background()
+
+	// Create temp project dir
+	projectDir := t.TempDir()
+	fileSet, err := git.NewFileSet(projectDir)
+	require.NoError(t, err)
+	state := Snapshot{
+		SnapshotState: &SnapshotState{
+			LastModifiedTimes:  make(map[string]time.Time),
+			LocalToRemoteNames: make(map[string]string),
+			RemoteToLocalNames: make(map[string]string),
+			ContentHashes:      make(map[string]string),
+		},
+		contentHash: true,
+	}
+
+	helloPath := filepath.Join(projectDir, "hello.txt")
+	f1 := testfile.CreateFile(t, helloPath)
+	f1.Overwrite(t, "hello")
+	defer f1.Close(t)
+
+	files, err := fileSet.All()
+	assert.NoError(t, err)
+	change, err := state.diff(ctx, files)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", state.ContentHashes["hello.txt"])
+
+	// Rewriting the file with the same contents does not upload it
+	f1.Overwrite(t, "hello")
+	now := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(helloPath, now, now))
+	files, err = fileSet.All()
+	assert.NoError(t, err)
+	change, err = state.diff(ctx, files)
+	assert.NoError(t, err)
+	assert.Empty(t, change.put)
+
+	// Changing the contents uploads the file, even if its mtime goes back
+	f1.Overwrite(t, "world")
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(helloPath, past, past))
+	files, err = fileSet.All()
+	assert.NoError(t, err)
+	change, err = state.diff(ctx, files)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+}
+
+func TestContentHashDisabledDropsHashes(t *testing.T) {
+	ctx := context.Background()
+
+	projectDir := t.TempDir()
+	fileSet, err := git.NewFileSet(projectDir)
+	require.NoError(t, err)
+	f1 := testfile.CreateFile(t, filepath.Join(projectDir, "hello.txt"))
+	defer f1.Close(t)
+
+	state := Snapshot{
+		SnapshotState: &SnapshotState{
+			LastModifiedTimes:  map[string]time.Time{"hello.txt": {}},
+			LocalToRemoteNames: map[string]string{"hello.txt": "hello.txt"},
+			RemoteToLocalNames: map[string]string{"hello.txt": "hello.txt"},
+			ContentHashes:      map[string]string{"hello.txt": "stale"},
+		},
+	}
+
+	// Without content hashes, mtimes determine what is uploaded
+	files, err := fileSet.All()
+	assert.NoError(t, err)
+	change, err := state.diff(ctx, files)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+	assert.Empty(t, state.ContentHashes)
+}
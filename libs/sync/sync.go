@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -22,6 +23,22 @@ type SyncOptions struct {
 
 	Full bool
 
+	// Record content hashes of files in the snapshot and use them to detect
+	// changes, instead of relying on modified times alone.
+	ContentHash bool
+
+	// Compute the changes to synchronize and report them as a start event
+	// without applying them or saving the snapshot. See [Sync.RunOnce].
+	DryRun bool
+
+	// Download changes made to files in the remote path since the last sync
+	// before uploading local changes. See [Sync.RunOnce].
+	Pull bool
+
+	// How to resolve files that were changed both locally and remotely
+	// since the last sync. Only applies if Pull is set.
+	Conflicts ConflictStrategy
+
 	SnapshotBasePath string
 
 	PollInterval time.Duration
@@ -69,8 +86,38 @@ func New(ctx context.Context, opts SyncOptions) (*Sync, error) {
 		return nil, err
 	}
 
+	// Pulling changes the local files, so it cannot be part of a dry run.
+	if opts.DryRun && opts.Pull {
+		return nil, fmt.Errorf("a dry run cannot pull remote changes")
+	}
+
+	// The remote path is in the workspace, in DBFS, or in a volume.
+	typ, rootPath := parseRemotePath(opts.RemotePath)
+	if opts.Pull && typ == remotePathVolume {
+		// The Files API cannot list directories, so remote changes cannot be found.
+		return nil, fmt.Errorf("pulling remote changes is not supported for volumes")
+	}
+
+	var remote filer.Filer
+	switch typ {
+	case remotePathDbfs:
+		remote, err = filer.NewDbfsClient(opts.WorkspaceClient, rootPath)
+	case remotePathVolume:
+		remote, err = filer.NewFilesClient(opts.WorkspaceClient, rootPath)
+	default:
+		remote, err = filer.NewWorkspaceFilesClient(opts.WorkspaceClient, rootPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	// Verify that the remote path we're about to synchronize to is valid and allowed.
-	err = EnsureRemotePathIsUsable(ctx, opts.WorkspaceClient, opts.RemotePath, opts.CurrentUser)
+	// A dry run does not create the remote path if it does not exist.
+	if typ == remotePathWorkspace {
+		err = ensureRemotePathIsUsable(ctx, opts.WorkspaceClient, opts.RemotePath, opts.CurrentUser, !opts.DryRun)
+	} else {
+		err = ensureFilerPathIsUsable(ctx, remote, typ, opts.RemotePath)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -97,11 +144,6 @@ func New(ctx context.Context, opts SyncOptions) (*Sync, error) {
 		}
 	}
 
-	filer, err := filer.NewWorkspaceFilesClient(opts.WorkspaceClient, opts.RemotePath)
-	if err != nil {
-		return nil, err
-	}
-
 	return &Sync{
 		SyncOptions: &opts,
 
@@ -109,7 +151,7 @@ func New(ctx context.Context, opts SyncOptions) (*Sync, error) {
 		includeFileSet: includeFileSet,
 		excludeFileSet: excludeFileSet,
 		snapshot:       snapshot,
-		filer:          filer,
+		filer:          remote,
 		notifier:       &NopNotifier{},
 		seq:            0,
 	}, nil
@@ -150,7 +192,26 @@ func (s *Sync) notifyComplete(ctx context.Context, d diff) {
 	s.seq++
 }
 
+// RunOnce synchronizes changes to local files since the last sync.
+//
+// In pull mode, changes to remote files since the last sync are first downloaded
+// to the local path. If any file was changed on both sides, the conflict strategy
+// determines which change is kept. By default, nothing is synchronized and a
+// [ConflictError] is returned.
+//
+// In dry run mode, the changes are sent as a single start event instead.
 func (s *Sync) RunOnce(ctx context.Context) error {
+	if s.DryRun {
+		return s.dryRun(ctx)
+	}
+
+	if s.Pull {
+		err := s.pull(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
 	files, err := getFileList(ctx, s)
 	if err != nil {
 		return err
@@ -161,13 +222,28 @@ func (s *Sync) RunOnce(ctx context.Context) error {
 		return err
 	}
 
+	err = s.apply(ctx, change)
+	if err != nil {
+		return err
+	}
+
+	// Record the modified times of the files that were just uploaded,
+	// such that they are not mistaken for remote changes by the next pull.
+	if s.Pull && len(change.put) > 0 {
+		return s.recordRemoteModifiedTimes(ctx)
+	}
+	return nil
+}
+
+// apply applies the diff to the remote path and persists the updated snapshot.
+func (s *Sync) apply(ctx context.Context, change diff) error {
 	s.notifyStart(ctx, change)
 	if change.IsEmpty() {
 		s.notifyComplete(ctx, change)
 		return nil
 	}
 
-	err = s.applyDiff(ctx, change)
+	err := s.applyDiff(ctx, change)
 	if err != nil {
 		return err
 	}
@@ -182,6 +258,27 @@ func (s *Sync) RunOnce(ctx context.Context) error {
 	return nil
 }
 
+// dryRun computes the changes to synchronize and notifies a start event for them.
+// The remote path and the snapshot are left unchanged.
+func (s *Sync) dryRun(ctx context.Context) error {
+	files, err := getFileList(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	// Computing the diff updates the in-memory snapshot state; restore it,
+	// such that the changes are not considered applied.
+	state := s.snapshot.SnapshotState
+	change, err := s.snapshot.diff(ctx, files)
+	s.snapshot.SnapshotState = state
+	if err != nil {
+		return err
+	}
+
+	s.notifier.Notify(ctx, newEventDryRun(s.seq, change))
+	return nil
+}
+
 func getFileList(ctx context.Context, s *Sync) ([]fileset.File, error) {
 	// tradeoff: doing portable monitoring only due to macOS max descriptor manual ulimit setting requirement
 	// https://github.com/gorakhargosh/watchdog/blob/master/src/watchdog/observers/kqueue.py#L394-L418
@@ -224,7 +321,26 @@ func (s *Sync) SnapshotPath() string {
 	return s.snapshot.SnapshotPath
 }
 
+// RunContinuous synchronizes changes to local files as they happen.
+// Changes are detected by watching the file system for events. If this is not
+// possible, for example because the limit on the number of watches is reached,
+// it falls back to scanning all files every poll interval.
 func (s *Sync) RunContinuous(ctx context.Context) error {
+	w, err := newWatcher(s)
+	if err != nil {
+		log.Warnf(ctx, "Cannot watch for file changes; falling back to polling every %s: %s", s.PollInterval, err)
+		return s.runPolling(ctx)
+	}
+
+	err = s.runWatching(ctx, w)
+	if errors.Is(err, errWatchFailed) {
+		log.Warnf(ctx, "Cannot watch for file changes; falling back to polling every %s: %s", s.PollInterval, err)
+		return s.runPolling(ctx)
+	}
+	return err
+}
+
+func (s *Sync) runPolling(ctx context.Context) error {
 	ticker := time.NewTicker(s.PollInterval)
 	defer ticker.Stop()
 
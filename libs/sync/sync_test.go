@@ -8,6 +8,7 @@ import (
 
 	"github.com/databricks/cli/libs/fileset"
 	"github.com/databricks/cli/libs/git"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -162,3 +163,33 @@ func TestRecursiveExclude(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, len(fileList), 7)
 }
+
+func TestRunOnceDryRun(t *testing.T) {
+	ctx := context.Background()
+	dir := setupFiles(t)
+	s := newTestSync(t, dir, nil, nil)
+	s.DryRun = true
+	events := s.Events()
+
+	err := s.RunOnce(ctx)
+	require.NoError(t, err)
+	s.Close()
+
+	var starts []*EventStart
+	for e := range events {
+		start, ok := e.(*EventStart)
+		require.True(t, ok, "unexpected event: %v", e)
+		starts = append(starts, start)
+	}
+	require.Len(t, starts, 1)
+	assert.True(t, starts[0].DryRun)
+	assert.Contains(t, starts[0].Put, "test/sub1/sub2/g.go")
+	assert.Equal(t, []string{"test/sub1/sub2"}, starts[0].Mkdir)
+
+	// Neither the remote path nor the snapshot is changed.
+	entries, err := s.filer.ReadDir(ctx, ".")
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	assert.NoFileExists(t, s.snapshot.SnapshotPath)
+	assert.Empty(t, s.snapshot.LastModifiedTimes)
+}
@@ -0,0 +1,372 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/databricks/cli/libs/fileset"
+	"github.com/databricks/cli/libs/log"
+	"github.com/fsnotify/fsnotify"
+)
+
+// Duration without further file system events after which pending changes are synchronized.
+// This coalesces bursts of events, for example during a `git checkout`, into a single sync.
+const watchDebounce = 250 * time.Millisecond
+
+// Maximum duration that pending changes are held back while events keep coming in.
+const watchMaxDelay = 5 * time.Second
+
+// errWatchFailed is returned if the file system can no longer be watched,
+// for example because the limit on the number of watches has been reached.
+var errWatchFailed = errors.New("failed to watch for file changes")
+
+// watcher watches the directories of the local path for changes.
+type watcher struct {
+	*fsnotify.Watcher
+
+	s *Sync
+}
+
+func newWatcher(s *Sync) (*watcher, error) {
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &watcher{Watcher: fw, s: s}
+	err = w.addRecursive(".")
+	if err != nil {
+		fw.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// skipDirectory returns true if no file in the directory can be synchronized.
+// Directories ignored by Git may contain files matched by include patterns,
+// so they are only skipped if there are no include patterns.
+func (w *watcher) skipDirectory(relPath string) (bool, error) {
+	if relPath == "." || len(w.s.Include) > 0 {
+		return false, nil
+	}
+	return w.s.fileSet.IgnoreDirectory(relPath)
+}
+
+// addRecursive adds watches for the directory and all of its subdirectories.
+func (w *watcher) addRecursive(relPath string) error {
+	root := filepath.Join(w.s.LocalPath, relPath)
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// The directory may have been removed in the meantime.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(w.s.LocalPath, path)
+		if err != nil {
+			return err
+		}
+		skip, err := w.skipDirectory(rel)
+		if err != nil {
+			return fmt.Errorf("cannot check if %s should be ignored: %w", rel, err)
+		}
+		if skip {
+			return filepath.SkipDir
+		}
+
+		err = w.Add(path)
+		if err != nil {
+			return fmt.Errorf("%w: cannot watch %s: %s", errWatchFailed, rel, err)
+		}
+		return nil
+	})
+}
+
+// relPath returns the path of an event relative to the local path.
+func (w *watcher) relPath(path string) (string, error) {
+	return filepath.Rel(w.s.LocalPath, path)
+}
+
+func (s *Sync) runWatching(ctx context.Context, w *watcher) error {
+	defer w.Close()
+
+	// Synchronize changes made before the watches were added.
+	err := s.RunOnce(ctx)
+	if err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(0)
+	<-timer.C
+	defer timer.Stop()
+
+	pending := make(map[string]bool)
+	full := false
+	var deadline time.Time
+
+	// schedule (re)starts the timer for the pending changes.
+	schedule := func() {
+		now := time.Now()
+		if len(pending) == 0 && !full {
+			deadline = now.Add(watchMaxDelay)
+		}
+		fire := now.Add(watchDebounce)
+		if fire.After(deadline) {
+			fire = deadline
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(time.Until(fire))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case event, ok := <-w.Events:
+			if !ok {
+				return errWatchFailed
+			}
+			rel, err := w.relPath(event.Name)
+			if err != nil || rel == "." {
+				continue
+			}
+
+			// Watches on removed or renamed directories are stale.
+			if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+				_ = w.Remove(event.Name)
+			}
+
+			// Watch new directories. Files created in a directory before
+			// the watch was added are picked up when the change is synchronized.
+			if event.Has(fsnotify.Create) {
+				info, err := os.Lstat(event.Name)
+				if err == nil && info.IsDir() {
+					err = w.addRecursive(rel)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			schedule()
+			pending[rel] = true
+
+		case err, ok := <-w.Errors:
+			if !ok {
+				return errWatchFailed
+			}
+			if !errors.Is(err, fsnotify.ErrEventOverflow) {
+				return fmt.Errorf("%w: %s", errWatchFailed, err)
+			}
+
+			// Events were dropped; rescan all files to find the changes.
+			log.Debugf(ctx, "File system event queue overflowed; scanning all files")
+			schedule()
+			full = true
+
+		case <-timer.C:
+			if full {
+				err = s.RunOnce(ctx)
+			} else {
+				paths := make([]string, 0, len(pending))
+				for path := range pending {
+					paths = append(paths, path)
+				}
+				err = s.runPaths(ctx, paths)
+			}
+			if err != nil {
+				return err
+			}
+			pending = make(map[string]bool)
+			full = false
+		}
+	}
+}
+
+// runPaths synchronizes changes to the specified local paths, which may be files or directories.
+func (s *Sync) runPaths(ctx context.Context, changed []string) error {
+	// A change to ignore rules may affect the inclusion of any file.
+	for _, path := range changed {
+		if filepath.Base(path) == ".gitignore" {
+			return s.RunOnce(ctx)
+		}
+	}
+
+	paths, err := s.expandPaths(changed)
+	if err != nil {
+		return err
+	}
+
+	var files []fileset.File
+	for _, path := range paths {
+		f, ok, err := s.localFile(path)
+		if err != nil {
+			return err
+		}
+		if ok {
+			files = append(files, f)
+		}
+	}
+
+	change, err := s.snapshot.diffPaths(ctx, paths, files)
+	if err != nil {
+		return err
+	}
+
+	return s.apply(ctx, change)
+}
+
+// expandPaths returns the paths of all files that may be affected by changes to the specified paths.
+// A change to a directory affects all files in it. Files in a directory that was removed or renamed
+// may not have their own events, and files in a new directory may have been created before it was watched.
+func (s *Sync) expandPaths(changed []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var out []string
+	add := func(path string) {
+		if !seen[path] {
+			seen[path] = true
+			out = append(out, path)
+		}
+	}
+
+	for _, path := range changed {
+		add(path)
+
+		info, err := os.Lstat(filepath.Join(s.LocalPath, path))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		// The path no longer exists; include tracked files under it if it was a directory.
+		if err != nil {
+			if _, ok := s.snapshot.LastModifiedTimes[path]; ok {
+				continue
+			}
+			prefix := path + string(filepath.Separator)
+			for localName := range s.snapshot.LastModifiedTimes {
+				if strings.HasPrefix(localName, prefix) {
+					add(localName)
+				}
+			}
+			continue
+		}
+
+		if !info.IsDir() {
+			continue
+		}
+
+		// The path is a directory; include all files in it.
+		root := filepath.Join(s.LocalPath, path)
+		err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			rel, err := filepath.Rel(s.LocalPath, p)
+			if err != nil {
+				return err
+			}
+			add(rel)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(out)
+	return out, nil
+}
+
+// localFile returns the file at the specified path if it exists and is to be synchronized.
+// This applies the same rules as the full listing of files in [getFileList].
+func (s *Sync) localFile(relPath string) (fileset.File, bool, error) {
+	absPath := filepath.Join(s.LocalPath, relPath)
+	info, err := os.Lstat(absPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fileset.File{}, false, nil
+	}
+	if err != nil {
+		return fileset.File{}, false, err
+	}
+
+	// Directories and symlinks are not synchronized.
+	if !info.Mode().IsRegular() {
+		return fileset.File{}, false, nil
+	}
+
+	ok, err := s.includesFile(relPath)
+	if err != nil || !ok {
+		return fileset.File{}, false, err
+	}
+
+	return fileset.File{
+		DirEntry: fs.FileInfoToDirEntry(info),
+		Absolute: absPath,
+		Relative: relPath,
+	}, true, nil
+}
+
+// includesFile returns true if the file at the specified path is to be synchronized.
+// Files are included if they are not ignored by Git or if they match an include pattern,
+// unless they match an exclude pattern.
+func (s *Sync) includesFile(relPath string) (bool, error) {
+	// The exclude and include file sets ignore files that do not match their patterns.
+	ign, err := s.excludeFileSet.Ignorer().IgnoreFile(relPath)
+	if err != nil {
+		return false, err
+	}
+	if !ign {
+		return false, nil
+	}
+
+	ign, err = s.includeFileSet.Ignorer().IgnoreFile(relPath)
+	if err != nil {
+		return false, err
+	}
+	if !ign {
+		return true, nil
+	}
+
+	// Files in directories ignored by Git are never listed.
+	parts := strings.Split(relPath, string(filepath.Separator))
+	for i := 1; i < len(parts); i++ {
+		dir := filepath.Join(parts[:i]...)
+		ign, err := s.fileSet.IgnoreDirectory(dir)
+		if err != nil {
+			return false, fmt.Errorf("cannot check if %s should be ignored: %w", dir, err)
+		}
+		if ign {
+			return false, nil
+		}
+	}
+
+	ign, err = s.fileSet.IgnoreFile(relPath)
+	if err != nil {
+		return false, fmt.Errorf("cannot check if %s should be ignored: %w", relPath, err)
+	}
+	return !ign, nil
+}
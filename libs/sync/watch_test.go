@@ -0,0 +1,172 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/fileset"
+	"github.com/databricks/cli/libs/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSync(t *testing.T, dir string, include []string, exclude []string) *Sync {
+	fileSet, err := git.NewFileSet(dir)
+	require.NoError(t, err)
+	err = fileSet.EnsureValidGitIgnoreExists()
+	require.NoError(t, err)
+
+	inc, err := fileset.NewGlobSet(dir, include)
+	require.NoError(t, err)
+	excl, err := fileset.NewGlobSet(dir, exclude)
+	require.NoError(t, err)
+
+	remote, err := filer.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+
+	snapshot := &Snapshot{
+		SnapshotPath: filepath.Join(t.TempDir(), "snapshot.json"),
+		Version:      LatestSnapshotVersion,
+		SnapshotState: &SnapshotState{
+			LastModifiedTimes:  make(map[string]time.Time),
+			LocalToRemoteNames: make(map[string]string),
+			RemoteToLocalNames: make(map[string]string),
+		},
+	}
+
+	return &Sync{
+		SyncOptions: &SyncOptions{
+			LocalPath:    dir,
+			Include:      include,
+			Exclude:      exclude,
+			PollInterval: 10 * time.Millisecond,
+		},
+
+		fileSet:        fileSet,
+		includeFileSet: inc,
+		excludeFileSet: excl,
+		snapshot:       snapshot,
+		filer:          remote,
+		notifier:       &NopNotifier{},
+	}
+}
+
+func TestIncludesFileMatchesFileList(t *testing.T) {
+	ctx := context.Background()
+	dir := setupFiles(t)
+
+	for _, tc := range []struct {
+		include []string
+		exclude []string
+	}{
+		{nil, nil},
+		{nil, []string{"*.go"}},
+		{[]string{".databricks/*"}, nil},
+		{nil, []string{"test/**"}},
+	} {
+		s := newTestSync(t, dir, tc.include, tc.exclude)
+
+		files, err := getFileList(ctx, s)
+		require.NoError(t, err)
+		var expected []string
+		for _, f := range files {
+			expected = append(expected, f.Relative)
+		}
+
+		var actual []string
+		err = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+			require.NoError(t, err)
+			rel, err := filepath.Rel(dir, path)
+			require.NoError(t, err)
+			f, ok, err := s.localFile(rel)
+			require.NoError(t, err)
+			if ok {
+				actual = append(actual, f.Relative)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, expected, actual, "include: %v, exclude: %v", tc.include, tc.exclude)
+	}
+}
+
+func TestRunPaths(t *testing.T) {
+	ctx := context.Background()
+	dir := setupFiles(t)
+	s := newTestSync(t, dir, nil, nil)
+
+	err := s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Len(t, s.snapshot.LastModifiedTimes, 9)
+
+	// Remove a directory and add a new one with a file.
+	err = os.RemoveAll(filepath.Join(dir, "test", "sub1"))
+	require.NoError(t, err)
+	err = os.Mkdir(filepath.Join(dir, "new"), 0755)
+	require.NoError(t, err)
+	err = createFile(filepath.Join(dir, "new"), "h.go")
+	require.NoError(t, err)
+
+	err = s.runPaths(ctx, []string{filepath.Join("test", "sub1"), "new"})
+	require.NoError(t, err)
+
+	var names []string
+	for name := range s.snapshot.LastModifiedTimes {
+		names = append(names, filepath.ToSlash(name))
+	}
+	sort.Strings(names)
+	assert.Equal(t, []string{".gitignore", "a.go", "ab.go", "abc.go", "b.go", "c.go", "d.go", "new/h.go"}, names)
+
+	// The state after the incremental sync matches a full scan.
+	files, err := getFileList(ctx, s)
+	require.NoError(t, err)
+	full, err := NewSnapshotState(files)
+	require.NoError(t, err)
+	assert.Equal(t, full.LocalToRemoteNames, s.snapshot.LocalToRemoteNames)
+}
+
+func TestRunContinuousWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := setupFiles(t)
+	s := newTestSync(t, dir, nil, nil)
+	events := s.Events()
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.RunContinuous(ctx)
+	}()
+
+	waitForComplete := func() *EventSyncComplete {
+		for {
+			select {
+			case e := <-events:
+				if complete, ok := e.(*EventSyncComplete); ok {
+					return complete
+				}
+			case err := <-errc:
+				require.NoError(t, err)
+			case <-time.After(10 * time.Second):
+				require.FailNow(t, "timed out waiting for sync to complete")
+			}
+		}
+	}
+	// Wait for the initial sync to complete.
+	waitForComplete()
+
+	err := createFile(filepath.Join(dir, "test"), "new.go")
+	require.NoError(t, err)
+
+	e := waitForComplete()
+	assert.Equal(t, []string{"test/new.go"}, e.Put)
+
+	cancel()
+	assert.ErrorIs(t, <-errc, context.Canceled)
+}
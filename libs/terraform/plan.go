@@ -10,4 +10,39 @@ type Plan struct {
 
 	// If true, the plan is empty and applying it will not do anything
 	IsEmpty bool
+
+	// Summary of the changes applying the plan makes to each resource.
+	// Only populated if the plan is not empty.
+	Changes []ResourceChange
+}
+
+// Action describes what applying a plan does to a single resource.
+type Action string
+
+const (
+	ActionCreate   = Action("create")
+	ActionUpdate   = Action("update")
+	ActionRecreate = Action("recreate")
+	ActionDelete   = Action("delete")
+)
+
+// IsDestructive returns true if the action deletes the existing resource.
+func (a Action) IsDestructive() bool {
+	return a == ActionDelete || a == ActionRecreate
+}
+
+// ResourceChange summarizes the planned change to a single resource.
+type ResourceChange struct {
+	// Group of the resource in the bundle configuration (e.g. "jobs").
+	Group string `json:"group"`
+
+	// Key of the resource in the bundle configuration.
+	Key string `json:"key"`
+
+	// Action that applying the plan takes for this resource.
+	Action Action `json:"action"`
+
+	// Paths of the fields that differ between the deployed state and the
+	// configuration. Only populated for updates and recreations.
+	Fields []string `json:"fields,omitempty"`
 }